@@ -0,0 +1,212 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// 生成每个属性的Get方法，接收者为nil时返回零值，可以链式调用
+// 例如 order.GetCustomer().GetAddress().GetCity()，嵌套模式下只有最外层的结构体可以生成
+func generateAccessor(buff *bytes.Buffer, node *Node, config *Config) {
+	if node.formattedName == "" {
+		return
+	}
+	// 方法名不能和属性名重复
+	fields := make(map[string]struct{})
+	for _, child := range *node.children {
+		fields[child.formattedKey] = struct{}{}
+	}
+	for _, child := range *node.children {
+		if child.formattedKey == "" {
+			continue
+		}
+		if _, ok := fields["Get"+child.formattedKey]; !ok {
+			generateGetter(buff, node.formattedName, child)
+		}
+		if _, ok := fields["Set"+child.formattedKey]; !ok && config.SetterFlag {
+			generateSetter(buff, node.formattedName, child)
+		}
+	}
+}
+
+// 对象返回指针，方便链式调用
+func generateGetter(buff *bytes.Buffer, name string, node *Node) {
+	t := node.formattedType
+	value := "n." + node.formattedKey
+	if node.g == GroupO && !strings.HasPrefix(t, "*") {
+		if strings.HasPrefix(t, "Optional[") {
+			// Optional[T]，Valid为false时返回nil
+			t = "*" + strings.TrimSuffix(strings.TrimPrefix(t, "Optional["), "]")
+			buff.WriteString(fmt.Sprintf("\n\nfunc (n *%s) Get%s() %s {\n", name, node.formattedKey, t))
+			buff.WriteString(fmt.Sprintf("if n != nil && %s.Valid {\nreturn &%s.Value\n}\nreturn nil\n}\n", value, value))
+			return
+		}
+		t = "*" + t
+		value = "&" + value
+	}
+	zero := zeroValue(t)
+	if base := enumBaseType(node, t); base != "" {
+		// 枚举类型使用基础类型的零值
+		zero = zeroValue(base)
+	}
+	buff.WriteString(fmt.Sprintf("\n\nfunc (n *%s) Get%s() %s {\n", name, node.formattedKey, t))
+	buff.WriteString(fmt.Sprintf("if n != nil {\nreturn %s\n}\nreturn %s\n}\n", value, zero))
+}
+
+func generateSetter(buff *bytes.Buffer, name string, node *Node) {
+	buff.WriteString(fmt.Sprintf("\n\nfunc (n *%s) Set%s(v %s) {\n", name, node.formattedKey, node.formattedType))
+	buff.WriteString(fmt.Sprintf("n.%s = v\n}\n", node.formattedKey))
+}
+
+// 类型的零值
+func zeroValue(t string) string {
+	switch t {
+	case TypeString, TypeNumber:
+		return `""`
+	case TypeBool:
+		return "false"
+	case TypeInt, TypeInt64, TypeUint, TypeUint64, TypeFloat64:
+		return "0"
+	case TypeAny, "any":
+		return "nil"
+	}
+	if strings.HasPrefix(t, "*") || strings.HasPrefix(t, "[]") || strings.HasPrefix(t, "map[") {
+		return "nil"
+	}
+	return t + "{}"
+}
+
+// Lookup使用的错误类型和路径解析函数，只生成一次
+const lookupSource = `
+
+// FieldNotFoundError Lookup的路径中属性不存在
+type FieldNotFoundError struct {
+	Path string
+}
+
+func (e *FieldNotFoundError) Error() string {
+	return "field not found: " + e.Path
+}
+
+// IndexOutOfRangeError Lookup的路径中数组下标越界
+type IndexOutOfRangeError struct {
+	Path  string
+	Index int
+	Len   int
+}
+
+func (e *IndexOutOfRangeError) Error() string {
+	return "index out of range [" + strconv.Itoa(e.Index) + "] with length " + strconv.Itoa(e.Len) + ": " + e.Path
+}
+
+// lookupKey 拆分路径，返回第一个属性名和剩余的路径
+func lookupKey(path string) (string, string) {
+	path = strings.TrimPrefix(path, ".")
+	i := strings.IndexAny(path, ".[")
+	if i == -1 {
+		return path, ""
+	}
+	return path[:i], path[i:]
+}
+
+// lookupIndex 解析路径开头的数组下标，例如[2]，返回下标和剩余的路径
+func lookupIndex(full, path string, length int) (int, string, error) {
+	if !strings.HasPrefix(path, "[") {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	end := strings.IndexByte(path, ']')
+	if end == -1 {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	i, err := strconv.Atoi(path[1:end])
+	if err != nil {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	// 负数转换为uint后一定越界
+	if uint(i) >= uint(length) {
+		return 0, "", &IndexOutOfRangeError{Path: full, Index: i, Len: length}
+	}
+	return i, path[end+1:], nil
+}`
+
+// 生成Lookup方法，按照json的路径获取属性的值，例如data.items[2].price
+// 不使用反射，嵌套模式下匿名结构体不能定义方法，直接展开
+func generateLookup(buff *bytes.Buffer, node *Node, config *Config) {
+	if node.formattedName == "" {
+		return
+	}
+	typeAny := anyType(config)
+	fields := make(map[string]struct{})
+	for _, child := range *node.children {
+		fields[child.formattedKey] = struct{}{}
+	}
+	if _, ok := fields["Lookup"]; !ok {
+		buff.WriteString(fmt.Sprintf("\n\nfunc (n *%s) Lookup(path string) (%s, bool) {\n", node.formattedName, typeAny))
+		buff.WriteString("v, err := n.lookup(path, path)\nreturn v, err == nil\n}\n")
+	}
+	if _, ok := fields["LookupPath"]; !ok {
+		buff.WriteString(fmt.Sprintf("\n\nfunc (n *%s) LookupPath(path string) (%s, error) {\n", node.formattedName, typeAny))
+		buff.WriteString("return n.lookup(path, path)\n}\n")
+	}
+	buff.WriteString(fmt.Sprintf("\n\nfunc (n *%s) lookup(full, path string) (%s, error) {\n", node.formattedName, typeAny))
+	buff.WriteString("if n == nil {\nreturn nil, &FieldNotFoundError{Path: full}\n}\n")
+	buff.WriteString("if path == \"\" {\nreturn n, nil\n}\n")
+	writeLookupFields(buff, node, "n", "path", config.NestFlag)
+	buff.WriteString("return nil, &FieldNotFoundError{Path: full}\n}\n")
+}
+
+// 按照属性名查找，expr是结构体的表达式
+func writeLookupFields(buff *bytes.Buffer, node *Node, expr string, path string, inline bool) {
+	if len(*node.children) == 0 {
+		return
+	}
+	buff.WriteString(fmt.Sprintf("key, rest := lookupKey(%s)\nswitch key {\n", path))
+	for _, child := range *node.children {
+		value := expr + "." + child.formattedKey
+		buff.WriteString(fmt.Sprintf("case %q:\n", child.k))
+		buff.WriteString(fmt.Sprintf("if rest == \"\" {\nreturn %s, nil\n}\n", value))
+		writeLookupValue(buff, child, value, inline)
+	}
+	buff.WriteString("}\n")
+}
+
+// 按照数组下标查找，然后继续查找对象的属性
+func writeLookupValue(buff *bytes.Buffer, node *Node, value string, inline bool) {
+	dims := 0
+	switch node.g {
+	case GroupV1, GroupO1:
+		dims = 1
+	case GroupV2, GroupO2:
+		dims = 2
+	}
+	for d, index := range []string{"i", "j"}[:dims] {
+		buff.WriteString(fmt.Sprintf("%s, rest, err := lookupIndex(full, rest, len(%s))\n", index, value))
+		buff.WriteString("if err != nil {\nreturn nil, err\n}\n")
+		value = value + "[" + index + "]"
+		if d < dims-1 {
+			buff.WriteString(fmt.Sprintf("if rest == \"\" {\nreturn %s, nil\n}\n", value))
+		}
+	}
+	if dims > 0 {
+		buff.WriteString(fmt.Sprintf("if rest == \"\" {\nreturn %s, nil\n}\n", value))
+	}
+	if !isObject(node.g) {
+		// 基础类型不能继续查找
+		buff.WriteString("return nil, &FieldNotFoundError{Path: full}\n")
+		return
+	}
+	t := strings.TrimLeft(node.formattedType, "[]")
+	if strings.HasPrefix(t, "Optional[") {
+		buff.WriteString(fmt.Sprintf("if !%s.Valid {\nreturn nil, &FieldNotFoundError{Path: full}\n}\n", value))
+		value += ".Value"
+	}
+	if !inline {
+		buff.WriteString(fmt.Sprintf("return %s.lookup(full, rest)\n", value))
+		return
+	}
+	if strings.HasPrefix(t, "*") {
+		buff.WriteString(fmt.Sprintf("if %s == nil {\nreturn nil, &FieldNotFoundError{Path: full}\n}\n", value))
+	}
+	writeLookupFields(buff, node, value, "rest", inline)
+}
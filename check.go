@@ -0,0 +1,261 @@
+//go:build !tinygo
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 生成的代码只是类型声明，没有package和import，类型检查时使用的标准库的最小声明
+// 这样不依赖GOROOT和编译好的标准库，只包含生成的代码会用到的部分
+var stubPackages = map[string]string{
+	"encoding/json": `package json
+
+type Number string
+
+func (n Number) String() string
+func (n Number) Float64() (float64, error)
+func (n Number) Int64() (int64, error)
+func Marshal(v interface{}) ([]byte, error)
+func Unmarshal(data []byte, v interface{}) error
+`,
+	"database/sql": `package sql
+
+type NullString struct {
+	String string
+	Valid  bool
+}
+
+type NullBool struct {
+	Bool  bool
+	Valid bool
+}
+
+type NullFloat64 struct {
+	Float64 float64
+	Valid   bool
+}
+
+type NullInt64 struct {
+	Int64 int64
+	Valid bool
+}
+`,
+	"math/big": `package big
+
+type Int struct {
+	abs []uint
+	neg bool
+}
+
+func NewInt(x int64) *Int
+func (z *Int) SetString(s string, base int) (*Int, bool)
+func (x *Int) String() string
+`,
+	"fmt": `package fmt
+
+func Errorf(format string, a ...interface{}) error
+`,
+	"strconv": `package strconv
+
+func Atoi(s string) (int, error)
+func Itoa(i int) string
+func Quote(s string) string
+`,
+	"strings": `package strings
+
+func HasPrefix(s, prefix string) bool
+func IndexAny(s, chars string) int
+func IndexByte(s string, c byte) int
+func TrimPrefix(s, prefix string) string
+`,
+}
+
+// 包名和导入路径
+var stubImports = map[string]string{
+	"json":    "encoding/json",
+	"fmt":     "fmt",
+	"sql":     "database/sql",
+	"big":     "math/big",
+	"strconv": "strconv",
+	"strings": "strings",
+}
+
+type stubImporter struct {
+	fset     *token.FileSet
+	packages map[string]*types.Package
+}
+
+func (s *stubImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := s.packages[path]; ok {
+		return pkg, nil
+	}
+	src, ok := stubPackages[path]
+	if !ok {
+		return nil, fmt.Errorf("package %s not found", path)
+	}
+	file, err := parser.ParseFile(s.fset, path+".go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkg, err := (&types.Config{}).Check(path, s.fset, []*ast.File{file}, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.packages[path] = pkg
+	return pkg, nil
+}
+
+// 对生成的代码进行语法和类型检查，typePaths是结构体名称对应的json路径
+func checkSource(src []byte, typePaths map[string]string) []Diagnostic {
+	fset := token.NewFileSet()
+	// 先不加import解析一次，找到用到的包
+	file, err := parser.ParseFile(fset, "", append([]byte("package p\n\n"), src...), 0)
+	if err != nil {
+		return parseDiagnostics(err, 2, file, fset, typePaths)
+	}
+	var names []string
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Obj == nil && stubImports[ident.Name] != "" {
+				names = append(names, ident.Name)
+			}
+		}
+		return true
+	})
+	sort.Strings(names)
+	header := "package p\n\n"
+	for i, name := range names {
+		if i == 0 || names[i-1] != name {
+			header += fmt.Sprintf("import %q\n", stubImports[name])
+		}
+	}
+	header += "\n"
+	// 生成的代码的行号需要减去的行数
+	offset := strings.Count(header, "\n")
+	fset = token.NewFileSet()
+	file, err = parser.ParseFile(fset, "", append([]byte(header), src...), 0)
+	if err != nil {
+		return parseDiagnostics(err, offset, file, fset, typePaths)
+	}
+	var diagnostics []Diagnostic
+	config := types.Config{
+		Importer: &stubImporter{fset: fset, packages: make(map[string]*types.Package)},
+		Error: func(err error) {
+			var typeErr types.Error
+			// 以\t开头的是上一个错误的补充说明
+			if errors.As(err, &typeErr) && !strings.HasPrefix(typeErr.Msg, "\t") {
+				diagnostics = append(diagnostics, newDiagnostic(fset, file, typeErr.Pos, offset, typeErr.Msg, typePaths))
+			}
+		},
+	}
+	_, _ = config.Check("p", fset, []*ast.File{file}, nil)
+	return diagnostics
+}
+
+// 语法错误
+func parseDiagnostics(err error, offset int, file *ast.File, fset *token.FileSet, typePaths map[string]string) []Diagnostic {
+	var list scanner.ErrorList
+	if !errors.As(err, &list) {
+		return []Diagnostic{{Message: err.Error()}}
+	}
+	var diagnostics []Diagnostic
+	for _, e := range list {
+		d := Diagnostic{Line: e.Pos.Line - offset, Column: e.Pos.Column, Message: e.Msg}
+		// 语法错误严重时可能没有解析出文件的位置
+		if file != nil {
+			if f := fset.File(file.Pos()); f != nil && e.Pos.Offset <= f.Size() {
+				d.Path = jsonPath(file, f.Pos(e.Pos.Offset), typePaths)
+			}
+		}
+		diagnostics = append(diagnostics, d)
+	}
+	return diagnostics
+}
+
+func newDiagnostic(fset *token.FileSet, file *ast.File, pos token.Pos, offset int, msg string, typePaths map[string]string) Diagnostic {
+	position := fset.Position(pos)
+	return Diagnostic{
+		Path:    jsonPath(file, pos, typePaths),
+		Line:    position.Line - offset,
+		Column:  position.Column,
+		Message: msg,
+	}
+}
+
+// 根据代码的位置找到对应的json路径
+func jsonPath(file *ast.File, pos token.Pos, typePaths map[string]string) string {
+	for _, decl := range file.Decls {
+		if pos < decl.Pos() || pos >= decl.End() {
+			continue
+		}
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok || pos < ts.Pos() || pos >= ts.End() {
+					continue
+				}
+				path, ok := typePaths[ts.Name.Name]
+				if !ok {
+					return ""
+				}
+				return path + fieldPath(ts.Type, pos)
+			}
+		case *ast.FuncDecl:
+			// 方法对应接收者的类型
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				t := d.Recv.List[0].Type
+				if star, ok := t.(*ast.StarExpr); ok {
+					t = star.X
+				}
+				if ident, ok := t.(*ast.Ident); ok {
+					return typePaths[ident.Name]
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// 嵌套的结构体中，找到包含pos的属性
+func fieldPath(expr ast.Expr, pos token.Pos) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return fieldPath(t.X, pos)
+	case *ast.ArrayType:
+		if path := fieldPath(t.Elt, pos); path != "" {
+			return "[]" + path
+		}
+	case *ast.IndexExpr:
+		// Optional[struct{...}]
+		return fieldPath(t.Index, pos)
+	case *ast.StructType:
+		for _, field := range t.Fields.List {
+			if pos < field.Pos() || pos >= field.End() {
+				continue
+			}
+			key := ""
+			if field.Tag != nil {
+				tag, _ := strconv.Unquote(field.Tag.Value)
+				key = strings.Split(reflect.StructTag(tag).Get(DefaultTag), ",")[0]
+			}
+			if key == "" && len(field.Names) > 0 {
+				key = field.Names[0].Name
+			}
+			return "." + key + fieldPath(field.Type, pos)
+		}
+	}
+	// 不在嵌套的结构体中，去掉数组的标记
+	return ""
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	core "json-to-go"
+	"json-to-go/jsonparser"
+	"os"
+)
+
+const usage = `usage: cli <command> [flags] [file]
+
+commands:
+  format    格式化json，保留注释
+  minify    压缩json
+  escape    把json转义为字符串字面量的内容
+  unescape  把字符串字面量还原为json
+
+没有指定文件时从标准输入读取，输入的大小不能超过-max-bytes，嵌套不能超过256层
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err := run(os.Args[1], os.Args[2:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(command string, args []string) error {
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	maxBytes := flags.Int("max-bytes", core.DefaultMaxBytes, "输入的最大字节数")
+	var indent *string
+	var stripComments *bool
+	switch command {
+	case "format":
+		indent = flags.String("indent", "  ", "每一层的缩进")
+	case "minify":
+		stripComments = flags.Bool("strip", false, "去掉注释")
+	case "escape", "unescape":
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	_ = flags.Parse(args)
+	data, err := readInput(flags.Arg(0), *maxBytes)
+	if err != nil {
+		return err
+	}
+	var result []byte
+	switch command {
+	case "format":
+		result, err = jsonparser.Format(data, *indent)
+	case "minify":
+		result, err = jsonparser.Minify(data, *stripComments)
+	case "escape":
+		result = jsonparser.EscapeString(data)
+	case "unescape":
+		result, err = jsonparser.UnescapeString(data)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Println(string(result))
+	return err
+}
+
+// 读取文件，文件名为空或者是"-"时读取标准输入，超过maxBytes时返回LimitError
+func readInput(name string, maxBytes int) ([]byte, error) {
+	var r io.Reader = os.Stdin
+	if name != "" && name != "-" {
+		file, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+	// 多读一个字节，用来判断是否超过限制
+	data, err := io.ReadAll(io.LimitReader(r, int64(maxBytes)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxBytes {
+		return nil, &core.LimitError{Limit: core.LimitBytes, Max: maxBytes}
+	}
+	return data, nil
+}
@@ -1,16 +1,23 @@
 package main
 
 import (
+	"errors"
 	core "json-to-go"
+	"json-to-go/jsonparser"
 	"strconv"
 	"strings"
 	"syscall/js"
+	"unicode"
 )
 
 // 需要在ide里设置os和arch
 // go to  Settings (Preferences) | Go | Vendoring & Build Tags  and then select  OS  ->  js  and  ARCH  ->  wasm.
 func main() {
 	js.Global().Set("JsonToGoGen", js.FuncOf(JsonToGoGen))
+	js.Global().Set("JsonFormat", js.FuncOf(JsonFormat))
+	js.Global().Set("JsonMinify", js.FuncOf(JsonMinify))
+	js.Global().Set("JsonEscape", js.FuncOf(JsonEscape))
+	js.Global().Set("JsonUnescape", js.FuncOf(JsonUnescape))
 	signal := make(chan struct{})
 	<-signal
 }
@@ -37,6 +44,14 @@ func JsonToGoGen(this js.Value, args []js.Value) interface{} {
 	commentStr := getStringVue(jsonValue, "comment")
 	comment, _ := strconv.Atoi(commentStr)
 	config.Comment = comment
+	commentValues, _ := strconv.Atoi(getStringVue(jsonValue, "commentValues"))
+	config.CommentValues = commentValues
+	config.CommentPositionFlag = jsonValue.Get("commentPositionFlag").Truthy()
+	commentStyle, _ := strconv.Atoi(getStringVue(jsonValue, "commentStyle"))
+	config.CommentStyle = commentStyle
+	config.CommentNameFlag = jsonValue.Get("commentNameFlag").Truthy()
+	commentWidth, _ := strconv.Atoi(getStringVue(jsonValue, "commentWidth"))
+	config.CommentWidth = commentWidth
 	pointerFlag := getStringVue(jsonValue, "pointerFlag")
 	if pointerFlag == "true" {
 		config.PointerFlag = true
@@ -47,17 +62,169 @@ func JsonToGoGen(this js.Value, args []js.Value) interface{} {
 	}
 	accessorFlag := jsonValue.Get("accessorFlag").Bool() // 获取 accessorFlag 参数
 	config.AccessorFlag = accessorFlag
-	generate, err := core.Generate(jsonStr, &config)
+	config.SetterFlag = jsonValue.Get("setterFlag").Truthy()
+	config.ExampleFlag = jsonValue.Get("exampleFlag").Truthy()
+	config.PackageName = getStringVue(jsonValue, "packageName")
+	quoted, _ := strconv.Atoi(getStringVue(jsonValue, "quoted"))
+	config.Quoted = quoted
+	overflow, _ := strconv.Atoi(getStringVue(jsonValue, "overflow"))
+	config.Overflow = overflow
+	numberPolicy, _ := strconv.Atoi(getStringVue(jsonValue, "numberPolicy"))
+	config.NumberPolicy = numberPolicy
+	nullable, _ := strconv.Atoi(getStringVue(jsonValue, "nullable"))
+	config.Nullable = nullable
+	nameStrategy, _ := strconv.Atoi(getStringVue(jsonValue, "nameStrategy"))
+	config.NameStrategy = nameStrategy
+	config.SingularFlag = jsonValue.Get("singularFlag").Truthy()
+	config.Initialisms = getStringsVue(jsonValue, "initialisms")
+	config.WordDictionary = getStringsVue(jsonValue, "wordDictionary")
+	pinyin, _ := strconv.Atoi(getStringVue(jsonValue, "pinyin"))
+	config.Pinyin = pinyin
+	config.TransliterateFlag = jsonValue.Get("transliterateFlag").Truthy()
+	reserved, _ := strconv.Atoi(getStringVue(jsonValue, "reserved"))
+	config.Reserved = reserved
+	config.ReservedPrefix = getStringVue(jsonValue, "reservedPrefix")
+	// 格式为old=new，多个使用逗号分割
+	for _, s := range getStringsVue(jsonValue, "reservedNames") {
+		if k, v, ok := strings.Cut(s, "="); ok {
+			if config.ReservedNames == nil {
+				config.ReservedNames = make(map[string]string)
+			}
+			config.ReservedNames[k] = v
+		}
+	}
+	config.GoVersion = getStringVue(jsonValue, "goVersion")
+	config.EnumFlag = jsonValue.Get("enumFlag").Truthy()
+	config.EnumMaxValues = getIntVue(jsonValue, "enumMaxValues", core.DefaultEnumMaxValues)
+	config.EnumMinRepeat = getIntVue(jsonValue, "enumMinRepeat", core.DefaultEnumMinRepeat)
+	config.EnumMethodFlag = jsonValue.Get("enumMethodFlag").Truthy()
+	config.EnumStrictFlag = jsonValue.Get("enumStrictFlag").Truthy()
+	config.CheckFlag = jsonValue.Get("checkFlag").Truthy()
+	sample, _ := strconv.Atoi(getStringVue(jsonValue, "sample"))
+	config.Sample = sample
+	sampleSize, _ := strconv.Atoi(getStringVue(jsonValue, "sampleSize"))
+	config.SampleSize = sampleSize
+	// 限制输入和生成的代码，没有配置时使用默认值，避免wasm栈溢出
+	config.MaxDepth = getIntVue(jsonValue, "maxDepth", core.DefaultMaxDepth)
+	config.MaxBytes = getIntVue(jsonValue, "maxBytes", core.DefaultMaxBytes)
+	config.MaxFields = getIntVue(jsonValue, "maxFields", core.DefaultMaxFields)
+	config.MaxTypes = getIntVue(jsonValue, "maxTypes", core.DefaultMaxTypes)
+	var generate string
+	var err error
+	// 按记录读取，支持换行分隔的json(NDJSON)和采样
+	if jsonValue.Get("streamFlag").Truthy() {
+		generate, err = core.GenerateReader(strings.NewReader(jsonStr), &config)
+	} else {
+		generate, err = core.Generate(jsonStr, &config)
+	}
+	var checkErr *core.CheckError
+	// 类型检查的问题作为警告，同时返回生成的代码
+	if errors.As(err, &checkErr) && generate != err.Error() {
+		var warnings []interface{}
+		for _, d := range checkErr.Diagnostics {
+			warnings = append(warnings, d.String())
+		}
+		return map[string]interface{}{
+			"code":     0,
+			"data":     generate,
+			"warnings": warnings,
+		}
+	}
 	if err != nil {
 		return map[string]interface{}{
 			"code":    500,
 			"message": generate,
 		}
 	}
-	return map[string]interface{}{
+	result := map[string]interface{}{
 		"code": 0,
 		"data": generate,
 	}
+	// 同时生成往返测试文件
+	if jsonValue.Get("testFlag").Truthy() {
+		test, err := core.GenerateTest(jsonStr, &config)
+		if err != nil {
+			return map[string]interface{}{
+				"code":    500,
+				"message": test,
+			}
+		}
+		result["test"] = test
+	}
+	return result
+}
+
+// JsonFormat 格式化json，保留注释，indent默认两个空格
+func JsonFormat(this js.Value, args []js.Value) interface{} {
+	jsonValue := args[0]
+	data, err := getToolInput(jsonValue)
+	if err != nil {
+		return toolResult(nil, err)
+	}
+	indent := getStringVue(jsonValue, "indent")
+	if indent == "" {
+		indent = "  "
+	}
+	return toolResult(jsonparser.Format(data, indent))
+}
+
+// JsonMinify 压缩json，stripComments为true时去掉注释
+func JsonMinify(this js.Value, args []js.Value) interface{} {
+	jsonValue := args[0]
+	data, err := getToolInput(jsonValue)
+	if err != nil {
+		return toolResult(nil, err)
+	}
+	return toolResult(jsonparser.Minify(data, jsonValue.Get("stripComments").Truthy()))
+}
+
+// JsonEscape 把json转义为字符串字面量的内容
+func JsonEscape(this js.Value, args []js.Value) interface{} {
+	data, err := getToolInput(args[0])
+	if err != nil {
+		return toolResult(nil, err)
+	}
+	return toolResult(jsonparser.EscapeString(data), nil)
+}
+
+// JsonUnescape 把字符串字面量还原为json
+func JsonUnescape(this js.Value, args []js.Value) interface{} {
+	data, err := getToolInput(args[0])
+	if err != nil {
+		return toolResult(nil, err)
+	}
+	return toolResult(jsonparser.UnescapeString(data))
+}
+
+// 工具函数的输入，和JsonToGoGen一样限制大小，嵌套层数由jsonparser限制
+func getToolInput(jsonValue js.Value) ([]byte, error) {
+	jsonStr := getStringVue(jsonValue, "jsonStr")
+	if maxBytes := getIntVue(jsonValue, "maxBytes", core.DefaultMaxBytes); len(jsonStr) > maxBytes {
+		return nil, &core.LimitError{Limit: core.LimitBytes, Max: maxBytes}
+	}
+	return []byte(jsonStr), nil
+}
+
+// 和JsonToGoGen相同的返回格式
+func toolResult(data []byte, err error) interface{} {
+	if err != nil {
+		return map[string]interface{}{
+			"code":    500,
+			"message": err.Error(),
+		}
+	}
+	return map[string]interface{}{
+		"code": 0,
+		"data": string(data),
+	}
+}
+
+// 获取数字，没有配置或者不是正数时使用默认值
+func getIntVue(jsonValue js.Value, key string, defaultValue int) int {
+	if value, err := strconv.Atoi(getStringVue(jsonValue, key)); err == nil && value > 0 {
+		return value
+	}
+	return defaultValue
 }
 
 func getStringVue(jsonValue js.Value, key string) string {
@@ -67,3 +234,10 @@ func getStringVue(jsonValue js.Value, key string) string {
 	}
 	return ""
 }
+
+// 逗号或者空白分割的字符串
+func getStringsVue(jsonValue js.Value, key string) []string {
+	return strings.FieldsFunc(getStringVue(jsonValue, key), func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+}
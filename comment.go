@@ -0,0 +1,263 @@
+package core
+
+import (
+	"json-to-go/jsonparser"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 注释的输出方式
+const (
+	// 原样输出json中的注释
+	CommentStyle0 = iota
+	// 转换为go风格的//注释，去掉装饰字符，可以加上字段名前缀，按照CommentWidth换行
+	CommentStyle1
+)
+
+// 注释的装饰字符，只由这些字符组成的行会被去掉
+const commentDecoration = "*/-=#~_+"
+
+// 按照注释的输出方式转换注释，name是注释所属的字段名或结构体名称，lineEnd为true时合并为一行，用于行尾注释
+func renderComment(comment string, name string, lineEnd bool, config *Config) string {
+	if comment == "" || config.CommentStyle != CommentStyle1 {
+		return comment
+	}
+	lines := commentLines(comment)
+	if len(lines) == 0 {
+		return ""
+	}
+	if lineEnd {
+		// 行尾注释换行后会变成下一个字段的注释，只能合并
+		text := make([]string, 0, len(lines))
+		for _, line := range lines {
+			if line != "" {
+				text = append(text, line)
+			}
+		}
+		return "// " + strings.Join(text, " ")
+	}
+	// golint要求注释以名称开头
+	if config.CommentNameFlag && name != "" && !strings.HasPrefix(lines[0], name) {
+		lines[0] = name + " " + lines[0]
+	}
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line == "" {
+			result = append(result, "//")
+			continue
+		}
+		for _, l := range wrapComment(line, config.CommentWidth) {
+			result = append(result, "// "+l)
+		}
+	}
+	return strings.Join(result, "\n")
+}
+
+// 提取注释的内容，去掉注释符号和装饰，连续的空行合并为一个，用来分隔段落
+func commentLines(comment string) []string {
+	var raw []string
+	for {
+		comment = strings.TrimLeft(comment, " \t\r\n")
+		if comment == "" {
+			break
+		}
+		if strings.HasPrefix(comment, "/*") {
+			body := comment[2:]
+			comment = ""
+			if end := strings.Index(body, "*/"); end != -1 {
+				body, comment = body[:end], body[end+2:]
+			}
+			raw = append(raw, strings.Split(body, "\n")...)
+			continue
+		}
+		line := comment
+		comment = ""
+		if end := strings.IndexByte(line, '\n'); end != -1 {
+			line, comment = line[:end], line[end+1:]
+		}
+		raw = append(raw, strings.TrimPrefix(line, "//"))
+	}
+	var lines []string
+	for _, line := range raw {
+		line = trimCommentLine(line)
+		if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// 去掉一行注释前后的装饰，例如块注释每行开头的*、多余的/和#
+func trimCommentLine(line string) string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimLeft(line, "/*")
+	// #开头的标题，例如"# 说明"，不处理"#1"这样的内容
+	if trimmed := strings.TrimLeft(line, "#"); trimmed != line && (trimmed == "" || trimmed[0] == ' ' || trimmed[0] == '\t') {
+		line = trimmed
+	}
+	line = strings.TrimSpace(strings.TrimRight(line, "* \t"))
+	if strings.Trim(line, commentDecoration) == "" {
+		return ""
+	}
+	return line
+}
+
+// 按照显示宽度换行，在空白处或者宽字符之间断开，超过宽度的单词不会被断开
+func wrapComment(line string, width int) []string {
+	if width <= 0 || textWidth(line) <= width {
+		return []string{line}
+	}
+	var lines []string
+	var cur strings.Builder
+	curWidth := 0
+	space := false
+	for len(line) > 0 {
+		r, size := utf8.DecodeRuneInString(line)
+		if r == ' ' || r == '\t' {
+			space = true
+			line = line[size:]
+			continue
+		}
+		// 下一个片段：连续的非空白字符，或者单个宽字符
+		n := size
+		if !isWideRune(r) {
+			for n < len(line) {
+				next, s := utf8.DecodeRuneInString(line[n:])
+				if next == ' ' || next == '\t' || isWideRune(next) {
+					break
+				}
+				n += s
+			}
+		}
+		word := line[:n]
+		line = line[n:]
+		sep := 0
+		if space && curWidth > 0 {
+			sep = 1
+		}
+		w := textWidth(word)
+		if curWidth > 0 && curWidth+sep+w > width {
+			lines = append(lines, cur.String())
+			cur.Reset()
+			curWidth = 0
+			sep = 0
+		}
+		if sep > 0 {
+			cur.WriteByte(' ')
+		}
+		cur.WriteString(word)
+		curWidth += sep + w
+		space = false
+	}
+	if curWidth > 0 {
+		lines = append(lines, cur.String())
+	}
+	return lines
+}
+
+// 显示宽度，中日韩字符和全角符号占两列
+func textWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		if isWideRune(r) {
+			width += 2
+		} else {
+			width++
+		}
+	}
+	return width
+}
+
+func isWideRune(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) ||
+		(r >= 0x3000 && r <= 0x303f) || (r >= 0xff00 && r <= 0xffef)
+}
+
+const (
+	// 示例值注释最多记录的不同值的数量
+	maxCommentValues = 20
+	// 示例值超过这个长度时截断
+	maxCommentValueLength = 40
+)
+
+// 记录属性的示例值，null不作为示例值
+func addCommentValue(node *Node, value *jsonparser.Value) {
+	var text string
+	switch value.Type {
+	case jsonparser.Null:
+		return
+	case jsonparser.String:
+		text = `"` + truncateValue(string(value.Data)) + `"`
+	default:
+		text = truncateValue(string(value.Data))
+	}
+	appendCommentValues(node, []string{text})
+}
+
+// 合并示例值，去掉重复的值，多记录一个用来判断是否还有更多的值
+func appendCommentValues(node *Node, values []string) {
+	for _, value := range values {
+		if len(node.e) > maxCommentValues {
+			return
+		}
+		exist := false
+		for _, e := range node.e {
+			if e == value {
+				exist = true
+				break
+			}
+		}
+		if !exist {
+			node.e = append(node.e, value)
+		}
+	}
+}
+
+func mergeCommentValues(n *Node, nodes []*Node) {
+	for _, node := range nodes {
+		appendCommentValues(n, node.e)
+	}
+}
+
+// 示例值的行尾注释，超过CommentValues个不同的值时以...结尾
+func valueComment(node *Node, config *Config) string {
+	if len(node.e) == 0 {
+		return ""
+	}
+	size := config.CommentValues
+	if size < 1 {
+		size = 1
+	} else if size > maxCommentValues {
+		size = maxCommentValues
+	}
+	values := node.e
+	more := ""
+	if len(values) > size {
+		values = values[:size]
+		more = ", ..."
+	}
+	return "// e.g. " + strings.Join(values, ", ") + more
+}
+
+// 截断过长的示例值，去掉控制字符
+func truncateValue(value string) string {
+	var buff strings.Builder
+	count := 0
+	for _, r := range value {
+		if count == maxCommentValueLength {
+			buff.WriteString("...")
+			break
+		}
+		if unicode.IsControl(r) {
+			r = ' '
+		}
+		buff.WriteRune(r)
+		count++
+	}
+	return buff.String()
+}
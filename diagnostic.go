@@ -0,0 +1,71 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Diagnostic 生成的代码的问题，通过json路径定位到对应的属性
+type Diagnostic struct {
+	// json路径，例如$.data.items[].price，数组使用[]表示，无法定位时为空
+	Path string
+	// 在生成的代码中的位置
+	Line   int
+	Column int
+	// 错误信息
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	path := d.Path
+	if path == "" {
+		path = "-"
+	}
+	return path + ": " + strconv.Itoa(d.Line) + ":" + strconv.Itoa(d.Column) + ": " + d.Message
+}
+
+// CheckError 生成的代码没有通过类型检查
+type CheckError struct {
+	Diagnostics []Diagnostic
+}
+
+func (e *CheckError) Error() string {
+	var array []string
+	for _, d := range e.Diagnostics {
+		array = append(array, d.String())
+	}
+	return strings.Join(array, "\n")
+}
+
+// 设置每个属性的json路径，数组使用[]表示
+// 所有属性共用一个缓冲区，子属性在父级路径的后面追加，不需要每一层重新拼接字符串
+func recursionPath(parent *Node, path string) {
+	buff := []byte(path)
+	parent.p = path
+	writePath(parent, &buff)
+}
+
+func writePath(parent *Node, buff *[]byte) {
+	prefix := len(*buff)
+	for _, node := range *parent.children {
+		*buff = append(append((*buff)[:prefix], '.'), node.k...)
+		switch node.g {
+		case GroupO1:
+			*buff = append(*buff, "[]"...)
+		case GroupO2:
+			*buff = append(*buff, "[][]"...)
+		}
+		node.p = string(*buff)
+		writePath(node, buff)
+	}
+	*buff = (*buff)[:prefix]
+}
+
+// 结构体名称对应的json路径
+func getTypePaths(parent *Node, all []*Node) map[string]string {
+	typePaths := map[string]string{parent.formattedName: parent.p}
+	for _, a := range all {
+		typePaths[a.formattedName] = a.p
+	}
+	return typePaths
+}
@@ -0,0 +1,222 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"json-to-go/jsonparser"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultEnumMaxValues 枚举默认最多包含的不同的值的数量
+	DefaultEnumMaxValues = 10
+	// DefaultEnumMinRepeat 枚举的每个值默认平均至少出现的次数
+	DefaultEnumMinRepeat = 2
+)
+
+// 属性出现过的不同的值和次数，用于推断枚举
+type enumValues struct {
+	// 值的类型，字符串或者数字，出现多种类型时不是枚举
+	kind jsonparser.ValueType
+	// 按照出现的顺序保存不同的值，字符串是转义后的内容
+	values []string
+	count  map[string]int
+	total  int
+	// 最多记录的不同的值的数量，超过时不是枚举
+	max      int
+	overflow bool
+	// 推断为枚举后的类型名称和基础类型
+	name string
+	base string
+}
+
+func enumMaxValues(config *Config) int {
+	if config.EnumMaxValues < 1 {
+		return DefaultEnumMaxValues
+	}
+	return config.EnumMaxValues
+}
+
+// 记录属性的值，只记录字符串和数字
+func addEnumValue(node *Node, value *jsonparser.Value, config *Config) {
+	if value.Type != jsonparser.String && value.Type != jsonparser.Number {
+		return
+	}
+	text := string(value.Data)
+	if value.Type == jsonparser.String {
+		unescaped, err := jsonparser.Unescape(value.Data, nil)
+		if err != nil {
+			return
+		}
+		text = string(unescaped)
+	}
+	node.enum = &enumValues{kind: value.Type, max: enumMaxValues(config)}
+	node.enum.add(text, 1)
+}
+
+func (e *enumValues) add(value string, count int) {
+	if e.overflow {
+		return
+	}
+	e.total += count
+	if _, ok := e.count[value]; !ok {
+		if len(e.values) == e.max {
+			// 不同的值太多，不再记录
+			e.overflow = true
+			e.values, e.count = nil, nil
+			return
+		}
+		if e.count == nil {
+			e.count = make(map[string]int)
+		}
+		e.values = append(e.values, value)
+	}
+	e.count[value] += count
+}
+
+// 合并两个节点记录的值
+func mergeEnumValues(n *Node, enum *enumValues) {
+	if enum == nil {
+		return
+	}
+	if n.enum == nil {
+		n.enum = &enumValues{kind: enum.kind, max: enum.max}
+	}
+	if enum.overflow || enum.kind != n.enum.kind {
+		n.enum.overflow = true
+		n.enum.values, n.enum.count = nil, nil
+		return
+	}
+	for _, value := range enum.values {
+		n.enum.add(value, enum.count[value])
+	}
+}
+
+// 在确定最终类型之后、处理null之前判断是否是枚举，记录基础类型
+func resolveEnum(node *Node, config *Config) {
+	e := node.enum
+	if !config.EnumFlag || e == nil || e.overflow || node.g != GroupV || node.o != "" {
+		return
+	}
+	switch node.t {
+	case TypeString:
+		if e.kind != jsonparser.String {
+			return
+		}
+	case TypeInt, TypeInt64, TypeUint, TypeUint64:
+		if e.kind != jsonparser.Number {
+			return
+		}
+	default:
+		return
+	}
+	// 包装sql.Null*的类型不能使用具名类型
+	if node.null && config.Nullable == Nullable2 {
+		return
+	}
+	repeat := config.EnumMinRepeat
+	if repeat < 1 {
+		repeat = DefaultEnumMinRepeat
+	}
+	if len(e.values) < 2 || e.total < len(e.values)*repeat {
+		return
+	}
+	e.base = node.t
+}
+
+// 为枚举属性设置类型名称，名称使用所属结构体的名称加上属性名，和已有的类型名称不重复
+// typeCount是已经使用的类型名称，enums按照先序遍历的顺序返回所有的枚举属性
+func recursionEnum(parent *Node, prefix string, typeCount map[string]int, enums *[]*Node, config *Config) {
+	for _, node := range *parent.children {
+		if node.enum != nil && node.enum.base != "" {
+			node.enum.name = uniqueName(typeCount, escapeTypeName(prefix+formatName(node.k, config), config))
+			// 可能是指针或者Optional[T]
+			node.t = strings.Replace(node.t, node.enum.base, node.enum.name, 1)
+			*enums = append(*enums, node)
+		}
+		// 嵌套模式下匿名结构体使用属性名
+		childPrefix := node.formattedName
+		if childPrefix == "" {
+			childPrefix = formatName(node.k, config)
+		}
+		recursionEnum(node, childPrefix, typeCount, enums, config)
+	}
+}
+
+// t是node的枚举类型时返回基础类型
+func enumBaseType(node *Node, t string) string {
+	if node.enum != nil && node.enum.name != "" && node.enum.name == t {
+		return node.enum.base
+	}
+	return ""
+}
+
+// 生成枚举类型、常量和方法，typeCount是已经使用的类型名称
+func writeEnums(buff *bytes.Buffer, enums []*Node, typeCount map[string]int, config *Config) {
+	for _, node := range enums {
+		e := node.enum
+		buff.WriteString(fmt.Sprintf("\n\n// %s %s的取值\ntype %s %s\n\nconst (\n", e.name, node.p, e.name, e.base))
+		names := make([]string, len(e.values))
+		for i, value := range e.values {
+			literal := value
+			// 负数使用Neg表示
+			suffix := strings.Replace(value, "-", "Neg", 1)
+			if e.base == TypeString {
+				literal = strconv.Quote(value)
+				// 值中的分隔符按照单词分割，例如in-progress转换为InProgress
+				suffix = formatName(strings.Map(func(r rune) rune {
+					if r == '-' || r == ' ' || r == '.' || r == '/' || r == ':' {
+						return '_'
+					}
+					return r
+				}, value), config)
+				if suffix == "" {
+					suffix = "Empty"
+				}
+			}
+			names[i] = uniqueName(typeCount, escapeTypeName(e.name+suffix, config))
+			buff.WriteString(fmt.Sprintf("%s %s = %s\n", names[i], e.name, literal))
+		}
+		buff.WriteString(")")
+		if config.EnumMethodFlag || config.EnumStrictFlag {
+			writeEnumMethods(buff, node, names, config)
+		}
+	}
+}
+
+// IsValid、String方法和严格模式的UnmarshalJSON，严格模式需要IsValid
+func writeEnumMethods(buff *bytes.Buffer, node *Node, names []string, config *Config) {
+	e := node.enum
+	buff.WriteString(fmt.Sprintf("\n\n// IsValid 是否是已知的值\nfunc (e %s) IsValid() bool {\nswitch e {\ncase %s:\nreturn true\n}\nreturn false\n}", e.name, strings.Join(names, ", ")))
+	if config.EnumMethodFlag {
+		// 转换为int会截断uint64和32位平台上的int64
+		value := "string(e)"
+		switch e.base {
+		case TypeInt, TypeInt64:
+			value = "strconv.FormatInt(int64(e), 10)"
+		case TypeUint, TypeUint64:
+			value = "strconv.FormatUint(uint64(e), 10)"
+		}
+		buff.WriteString(fmt.Sprintf("\n\nfunc (e %s) String() string {\nreturn %s\n}", e.name, value))
+	}
+	if config.EnumStrictFlag {
+		buff.WriteString(fmt.Sprintf(`
+
+// UnmarshalJSON 拒绝未知的值
+func (e *%[1]s) UnmarshalJSON(data []byte) error {
+if string(data) == "null" {
+return nil
+}
+var v %[2]s
+if err := json.Unmarshal(data, &v); err != nil {
+return err
+}
+if !%[1]s(v).IsValid() {
+return fmt.Errorf("invalid %[1]s: %%s", data)
+}
+*e = %[1]s(v)
+return nil
+}`, e.name, e.base))
+	}
+}
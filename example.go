@@ -0,0 +1,186 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"json-to-go/jsonparser"
+	"strconv"
+	"strings"
+)
+
+// ExampleName 生成的示例变量名
+const ExampleName = "Example"
+
+// 使用生成的类型，把json转换为go的字面量，例如 var Example = AutoGenerated{...}
+// 需要在生成结构体之后调用，依赖格式化后的名称和类型，root是解析好的json，不需要重新扫描
+func generateExample(buff *bytes.Buffer, parent *Node, root *jsonparser.Value, config *Config) error {
+	buff.WriteString(fmt.Sprintf("\n\nvar %s = ", ExampleName))
+	if root.Type != jsonparser.Array {
+		return writeExample(buff, parent, parent.formattedName, root, false, config)
+	}
+	buff.WriteString(fmt.Sprintf("[]%s{\n", parent.formattedName))
+	for _, value := range root.Children {
+		// 和Generate一样，只处理对象
+		if value.Type != jsonparser.Object {
+			continue
+		}
+		if err := writeExample(buff, parent, parent.formattedName, value, true, config); err != nil {
+			return err
+		}
+		buff.WriteString(",\n")
+	}
+	buff.WriteString("}")
+	return nil
+}
+
+// 按照类型t写入value的字面量，elide为true时省略复合字面量的类型，用于数组的元素
+func writeExample(buff *bytes.Buffer, node *Node, t string, value *jsonparser.Value, elide bool, config *Config) error {
+	if value.Type == jsonparser.Null {
+		buff.WriteString(zeroValue(t))
+		return nil
+	}
+	switch {
+	case strings.HasPrefix(t, "[]"):
+		if !elide {
+			buff.WriteString(t)
+		}
+		buff.WriteString("{\n")
+		for _, v := range value.Children {
+			if err := writeExample(buff, node, t[2:], v, true, config); err != nil {
+				return err
+			}
+			buff.WriteString(",\n")
+		}
+		buff.WriteString("}")
+		return nil
+	case t == TypeBigInt:
+		buff.WriteString(fmt.Sprintf("func() *big.Int {\nv, _ := new(big.Int).SetString(%q, 10)\nreturn v\n}()", value.Data))
+		return nil
+	case strings.HasPrefix(t, "*"):
+		if isObject(node.g) {
+			// 数组元素可以省略&
+			if !elide {
+				buff.WriteString("&")
+			}
+			return writeExample(buff, node, t[1:], value, elide, config)
+		}
+		// 基础类型不能直接取地址
+		buff.WriteString(fmt.Sprintf("func() %s {\nvar v %s = ", t, t[1:]))
+		if err := writeExample(buff, node, t[1:], value, false, config); err != nil {
+			return err
+		}
+		buff.WriteString("\nreturn &v\n}()")
+		return nil
+	case strings.HasPrefix(t, "Optional["):
+		buff.WriteString(t + "{Value: ")
+		if err := writeExample(buff, node, t[len("Optional["):len(t)-1], value, false, config); err != nil {
+			return err
+		}
+		buff.WriteString(", Valid: true}")
+		return nil
+	case config.Nullable == Nullable2 && sqlNullField(t) != "":
+		buff.WriteString(fmt.Sprintf("%s{%s: sql.%s{%s: ", t, t, t, sqlNullField(t)))
+		if err := writeExample(buff, node, sqlNullValueType(t), value, false, config); err != nil {
+			return err
+		}
+		buff.WriteString(", Valid: true}}")
+		return nil
+	case isObject(node.g):
+		return writeObjectExample(buff, node, t, value, elide, config)
+	case t == TypeAny || t == "any":
+		writeAnyExample(buff, value, anyType(config))
+		return nil
+	case t == TypeString || enumBaseType(node, t) == TypeString:
+		buff.WriteString(quoteExample(value.Data))
+		return nil
+	case t == TypeNumber:
+		buff.WriteString(fmt.Sprintf("json.Number(%q)", value.Data))
+		return nil
+	}
+	// 数字和布尔值直接使用json中的写法，包括",string"的字符串
+	buff.Write(value.Data)
+	return nil
+}
+
+// 对象的字面量，按照json中的顺序写入属性
+func writeObjectExample(buff *bytes.Buffer, node *Node, t string, value *jsonparser.Value, elide bool, config *Config) error {
+	if value.Type != jsonparser.Object {
+		return jsonparser.MalformedObjectError
+	}
+	children := make(map[string]*Node)
+	for _, child := range *node.children {
+		children[child.k] = child
+	}
+	if !elide {
+		buff.WriteString(t)
+	}
+	buff.WriteString("{\n")
+	for _, field := range objectFields(value) {
+		child, ok := children[string(field.Key)]
+		// null使用零值，不需要写入
+		if !ok || field.Type == jsonparser.Null {
+			continue
+		}
+		buff.WriteString(child.formattedKey + ": ")
+		if err := writeExample(buff, child, child.formattedType, field, false, config); err != nil {
+			return err
+		}
+		buff.WriteString(",\n")
+	}
+	buff.WriteString("}")
+	return nil
+}
+
+// 对象的所有属性，重复的属性和encoding/json一样使用最后一个值，避免字面量中出现重复的字段
+func objectFields(value *jsonparser.Value) []*jsonparser.Value {
+	var fields []*jsonparser.Value
+	index := make(map[string]int)
+	for _, field := range value.Children {
+		if i, ok := index[string(field.Key)]; ok {
+			fields[i] = field
+		} else {
+			index[string(field.Key)] = len(fields)
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// 类型是any的值，和encoding/json解析的结果保持一致，数字使用float64
+func writeAnyExample(buff *bytes.Buffer, value *jsonparser.Value, typeAny string) {
+	switch value.Type {
+	case jsonparser.String:
+		buff.WriteString(quoteExample(value.Data))
+	case jsonparser.Number:
+		buff.WriteString("float64(" + string(value.Data) + ")")
+	case jsonparser.Null:
+		buff.WriteString("nil")
+	case jsonparser.Object:
+		buff.WriteString("map[string]" + typeAny + "{\n")
+		for _, field := range objectFields(value) {
+			// 解析时key已经反转义
+			buff.WriteString(strconv.Quote(string(field.Key)) + ": ")
+			writeAnyExample(buff, field, typeAny)
+			buff.WriteString(",\n")
+		}
+		buff.WriteString("}")
+	case jsonparser.Array:
+		buff.WriteString("[]" + typeAny + "{\n")
+		for _, v := range value.Children {
+			writeAnyExample(buff, v, typeAny)
+			buff.WriteString(",\n")
+		}
+		buff.WriteString("}")
+	default:
+		buff.Write(value.Data)
+	}
+}
+
+// json字符串转换为go的字符串字面量
+func quoteExample(value []byte) string {
+	unescaped, err := jsonparser.Unescape(value, nil)
+	if err != nil {
+		unescaped = value
+	}
+	return strconv.Quote(string(unescaped))
+}
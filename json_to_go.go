@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // 大类型 group
@@ -30,14 +31,45 @@ const (
 	TypeFloat64 = "float64"
 	TypeInt     = "int"
 	TypeInt64   = "int64"
+	TypeUint    = "uint"
+	TypeUint64  = "uint64"
+	TypeBigInt  = "*big.Int"
 	TypeAny     = "interface{}"
 	TypeNil     = "nil" // 临时类型，属性为null的，数组为空的，都先用这个表示。最后再进行属性合并的时候会用到
+	TypeNumber  = "json.Number"
+	// 临时类型，负数，用来判断数字的符号
+	TypeNegInt   = "-int"
+	TypeNegInt64 = "-int64"
+	// 临时类型，超出int64和uint64范围的整数，最后根据Config.Overflow决定输出的类型
+	TypeOverflow = "overflow"
+	// 临时类型，字符串的内容是数字或布尔值，例如"123"，最后根据Config.Quoted决定输出的类型
+	// 数字是对应的数字临时类型加上引号，合并时和数字使用相同的规则
+	TypeQuotedInt      = `"` + TypeInt + `"`
+	TypeQuotedNegInt   = `"` + TypeNegInt + `"`
+	TypeQuotedInt64    = `"` + TypeInt64 + `"`
+	TypeQuotedNegInt64 = `"` + TypeNegInt64 + `"`
+	TypeQuotedUint64   = `"` + TypeUint64 + `"`
+	TypeQuotedFloat64  = `"` + TypeFloat64 + `"`
+	TypeQuotedOverflow = `"` + TypeOverflow + `"`
+	TypeQuotedBool     = `"` + TypeBool + `"`
 )
 
 const (
 	Comment0 = iota
 	Comment1
 	Comment2
+	// 使用属性的示例值生成行尾注释，例如// e.g. "2023-01-01"
+	Comment3
+)
+
+// 字符串形式的数字和布尔值的处理方式
+const (
+	// 保持string
+	Quoted0 = iota
+	// 使用数字或布尔类型，并在json tag上添加",string"
+	Quoted1
+	// 数字使用json.Number，同样添加",string"，布尔值同Quoted1
+	Quoted2
 )
 
 const (
@@ -45,6 +77,7 @@ const (
 	DefaultTag  = "json"
 	MaxInt32    = 1<<31 - 1
 	MinInt32    = -1 << 31
+	MaxInt64    = 1<<63 - 1
 )
 
 // https://github.com/golang/lint/blob/master/lint.go
@@ -92,16 +125,95 @@ var commonInitialisms = map[string]struct{}{
 type Config struct {
 	// tags
 	Tags []string
-	// 0忽略注释，1生成单行注释 2生成行尾注释
+	// 0忽略注释，1生成单行注释 2生成行尾注释 3生成示例值的行尾注释
 	Comment int
+	// Comment3时最多列出的不同示例值的数量，小于1时只列出第一个
+	CommentValues int
+	// 按照注释在json中的位置生成，需要Comment不为0
+	// 属性前面的注释生成在字段上面，对象前面的注释作为结构体的文档注释，同一行的注释保留在行尾
+	CommentPositionFlag bool
+	// 注释的输出方式，0原样输出，1转换为go风格的//注释
+	CommentStyle int
+	// 转换注释时，注释以字段名或结构体名称开头
+	CommentNameFlag bool
+	// 转换注释时的换行宽度，小于1时不换行
+	CommentWidth int
 	// 是否使用指针
 	PointerFlag bool
 	// 是否嵌套结构
 	NestFlag bool
-	// 控制是否生成访问函数
+	// 控制是否生成访问函数，为每个属性生成Get方法，并生成按照json路径查找的Lookup方法
+	// 嵌套模式下匿名结构体不能定义方法，只有最外层的结构体有访问函数，链式调用Get方法需要使用非嵌套模式
 	AccessorFlag bool
+	// 是否同时生成Set方法，需要AccessorFlag
+	SetterFlag bool
+	// 是否生成示例变量，使用生成的类型表示输入的json
+	ExampleFlag bool
+	// 生成测试文件时使用的包名，默认main
+	PackageName string
+	// 是否对生成的代码进行类型检查，有问题时返回*CheckError
+	CheckFlag bool
 	// 新增结构体类型选项
 	StructType string
+	// 0保持string，1使用",string"，2使用json.Number
+	Quoted int
+	// 超出范围的整数，0使用float64，1使用json.Number，2使用*big.Int
+	Overflow int
+	// 数字类型，0最小的类型，1整数统一使用int64，2统一使用float64，3没有负数时使用无符号类型
+	NumberPolicy int
+	// 出现过null的属性，0不处理，1使用指针，2使用包装sql.Null*的NullString等类型，3使用Optional[T]
+	Nullable int
+	// 非嵌套模式下结构体的命名，0重名时末尾加数字，1加上父级属性名作为前缀，2使用完整的json路径
+	NameStrategy int
+	// 数组内的对象是否使用单数作为结构体名称，例如items对应Item
+	SingularFlag bool
+	// 自定义的复数转单数的规则，在内置的规则之前按顺序匹配，使用小写
+	SingularRules []SingularRule
+	// 自定义的不规则的复数和单数，优先于内置的不规则复数，key使用小写，value为空表示单复数相同
+	Irregulars map[string]string
+	// 自定义的缩写，例如"SKU"、"OAuth"，属性名按照配置的大小写输出
+	Initialisms []string
+	// 自定义的单词，用于分割连在一起的小写属性名，例如配置"user"后userid转换为UserID
+	WordDictionary []string
+	// 汉字转拼音的方式，0只使用首字母，1使用完整的拼音
+	Pinyin int
+	// 是否转换其他文字：假名转罗马字，谚文转韩国语罗马字，西里尔字母转拉丁字母，去掉拉丁字母的变音符号
+	TransliterateFlag bool
+	// 结构体名称和生成的代码中的名称(例如Optional、Example)重复时的转义方式，0末尾加下划线，1加上前缀，2使用ReservedNames
+	Reserved int
+	// Reserved为1时使用的前缀，默认为X
+	ReservedPrefix string
+	// Reserved为2时保留名称对应的新名称，例如{"Optional": "OptionalValue"}
+	ReservedNames map[string]string
+	// 对象和数组嵌套的最大层数，为0时使用DefaultMaxDepth，小于0时不限制
+	// 不限制时嵌套过深的输入会导致无法恢复的栈溢出
+	MaxDepth int
+	// 输入的最大字节数，小于1时不限制
+	MaxBytes int
+	// 生成的属性的最大数量，小于1时不限制
+	MaxFields int
+	// 生成的结构体的最大数量，小于1时不限制
+	MaxTypes int
+	// 并发推断使用的协程数量，小于2时不并发
+	Parallel int
+	// 流式读取时的采样方式，0读取全部记录，1前N条，2蓄水池采样，3每N条取一条
+	Sample int
+	// 采样的数量N
+	SampleSize int
+	// 蓄水池采样的随机数种子，相同的种子采样结果相同
+	SampleSeed int64
+	// 推断枚举类型，不同的值的数量和重复的次数满足条件的字符串和整数属性，生成具名类型和常量
+	EnumFlag bool
+	// 枚举最多包含的不同的值的数量，小于1时使用DefaultEnumMaxValues
+	EnumMaxValues int
+	// 枚举的每个值平均至少出现的次数，小于1时使用DefaultEnumMinRepeat
+	EnumMinRepeat int
+	// 为枚举类型生成IsValid和String方法
+	EnumMethodFlag bool
+	// 严格模式，为枚举类型生成UnmarshalJSON，拒绝未知的值
+	EnumStrictFlag bool
+	// 目标go版本，例如"1.18"，用来控制any、泛型等和版本相关的代码，为空时按照1.18之前的版本处理，使用interface{}，Optional[T]改为指针
+	GoVersion string
 }
 
 type Node struct {
@@ -113,78 +225,134 @@ type Node struct {
 	g string
 	// 注释
 	c string
+	// 按照位置区分的注释：前面的注释、行尾注释、结束括号前的注释、结构体的文档注释
+	lc string
+	tc string
+	ec string
+	dc string
+	// 不同的示例值，用于Comment3
+	e []string
+	// 出现过的值，用于推断枚举
+	enum *enumValues
+	// json tag的选项，例如",string"
+	o string
+	// 是否出现过null
+	null bool
+	// json路径，例如$.data.items[]，用于定位生成的代码的问题
+	p string
+	// 推断时json路径的编号，用于检查MaxFields
+	pid int
 	// 嵌套结构
 	children *[]*Node
 	// 用来merge的
 	childrenMerge *[][]*Node
 	// childrenMerge 下标使用
 	cache map[string]int
-	// 用于存储格式化后的结构体名称和字段名。
+	// 用于存储格式化后的结构体名称、字段名和字段类型。
 	formattedName string
 	formattedKey  string
+	formattedType string
 }
 
 // Generate json字符串转对象，在前端进行了json5格式验证和格式化
 func Generate(jsonStr string, config *Config) (string, error) {
-	setJsonTag(config)
+	config = setJsonTag(config)
+	if err := checkBytes(len(jsonStr), config); err != nil {
+		return err.Error(), err
+	}
 	// 添加类型判断
 	if config.StructType == "map" {
 		return generateMap(jsonStr, config)
 	}
 	// 解析JSON
 	parent := NewNode(DefaultName, "", GroupO, "")
-	var err error
-	if jsonStr[0:1] == "[" {
-		err = jsonparser.ArrayEach([]byte(jsonStr), func(value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (bool, error) {
-			if dataType == jsonparser.Object {
-				err = recursionNode(parent, value, config)
-				if err != nil {
-					return false, err
+	state := newInferState(config)
+	// 一次解析得到完整的树，推断类型时不再重复扫描
+	root, err := parseDepth([]byte(jsonStr), config)
+	if err == nil {
+		if root.Type == jsonparser.Array {
+			var objects []*jsonparser.Value
+			for _, value := range root.Children {
+				if value.Type == jsonparser.Object {
+					objects = append(objects, value)
 				}
 			}
-			return true, nil
-		})
-	} else {
-		err = recursionNode(parent, []byte(jsonStr), config)
+			err = recursionNodes(parent, objects, state)
+		} else {
+			err = recursionNode(parent, root, state)
+		}
 	}
 	if err != nil {
-		fmt.Println(err)
 		return err.Error(), err
 	}
+	// 最外层是数组时，结构体的注释来自数组中的对象，和GenerateReader一致
+	if root.Type == jsonparser.Object {
+		parent.dc = string(root.LeadingComment)
+		parent.ec = string(root.EndComment)
+	}
+	return generateSource(parent, root, config)
+}
+
+// 根据解析好的节点生成代码，example是解析好的json，用于生成示例
+func generateSource(parent *Node, example *jsonparser.Value, config *Config) (string, error) {
+	var err error
 	// 合并数组内的对象和属性
 	mergeArrayNode(parent)
+	if err = checkNodes(parent, config); err != nil {
+		return err.Error(), err
+	}
+	// 确定临时类型最终输出的类型
+	recursionResolve(parent, config)
+	recursionPath(parent, "$")
 	var buff bytes.Buffer
 	all := make([]*Node, 0)
+	// 枚举类型的名称不能和结构体的名称重复
+	typeCount := make(map[string]int)
+	var enums []*Node
 	if config.NestFlag {
 		// 嵌套结构体
+		parent.formattedName = parent.k
+		if config.EnumFlag {
+			typeCount[parent.k] = 0
+			recursionEnum(parent, parent.k, typeCount, &enums, config)
+		}
+		writeDocComment(&buff, parent, config)
 		buff.WriteString(fmt.Sprintf("type %s ", parent.k))
 		nestKey := recursionWrite(parent, config)
 		buff.WriteString(nestKey)
 	} else {
-		recursionAdd(&all, parent)
-		// 格式化前name；格式化后name
-		nameMap := make(map[string]string)
-		// 转换后的name，如果重名了，后面加数字表示
-		nameCount := make(map[string]int)
+		collectStructs(&all, parent)
+		// 设置格式化后的结构体名称，结构体名称全局唯一
+		recursionName(parent, nil, make(map[string]int), config)
+		if config.EnumFlag {
+			for _, a := range all {
+				typeCount[a.formattedName] = 0
+			}
+			recursionEnum(parent, parent.formattedName, typeCount, &enums, config)
+		}
 		for i, a := range all {
-			// 设置格式化后的结构体名称
-			formattedName := formatKey(nameMap, nameCount, a.k)
-			a.formattedName = formattedName
-
-			buff.WriteString(fmt.Sprintf("type %s struct {\n", formatKey(nameMap, nameCount, a.k)))
+			writeDocComment(&buff, a, config)
+			buff.WriteString(fmt.Sprintf("type %s struct {\n", a.formattedName))
+			// 属性名只需要在结构体内唯一；格式化前name；格式化后name
+			nameMap := make(map[string]string)
+			// 转换后的name，如果重名了，后面加数字表示
+			nameCount := make(map[string]int)
 			for _, node := range *a.children {
-				if node.c != "" && config.Comment == Comment1 {
-					buff.WriteString(node.c + "\n")
+				// 设置格式化后的字段名称和类型
+				key := formatKey(nameMap, nameCount, node.k, config)
+				above, lineEnd := fieldComment(node, key, config)
+				if above != "" {
+					buff.WriteString(above + "\n")
 				}
-				// 设置格式化后的字段名称
-				key := formatKey(nameMap, nameCount, node.k)
 				node.formattedKey = key
-				if node.c != "" && config.Comment == Comment2 {
-					buff.WriteString(fmt.Sprintf("%s %s %s %s\n", key, formatType(key, node.t, node.g, config.PointerFlag), formatTag(node.k, config.Tags), node.c))
+				node.formattedType = formatType(node.formattedName, node, config)
+				if lineEnd != "" {
+					buff.WriteString(fmt.Sprintf("%s %s %s %s\n", key, node.formattedType, formatTag(node.k, node.o, config.Tags), lineEnd))
 				} else {
-					buff.WriteString(fmt.Sprintf("%s %s %s\n", key, formatType(key, node.t, node.g, config.PointerFlag), formatTag(node.k, config.Tags)))
+					buff.WriteString(fmt.Sprintf("%s %s %s\n", key, node.formattedType, formatTag(node.k, node.o, config.Tags)))
 				}
 			}
+			writeEndComment(&buff, a, config)
 			if i == len(all)-1 {
 				buff.WriteString("}")
 			} else {
@@ -192,42 +360,61 @@ func Generate(jsonStr string, config *Config) (string, error) {
 			}
 		}
 	}
+	writeEnums(&buff, enums, typeCount, config)
+	if hasOptional(parent, config) {
+		buff.WriteString(optionalSource)
+	}
+	writeSqlNull(&buff, parent, config)
+	if config.ExampleFlag {
+		err = generateExample(&buff, parent, example, config)
+		if err != nil {
+			return err.Error(), err
+		}
+	}
 	if config.AccessorFlag {
 		if config.NestFlag {
-			// 嵌套模式下只生成最外层结构体的访问函数
-			generateAccessor(&buff, parent)
+			// 嵌套模式下只生成最外层结构体的访问函数，匿名结构体不能定义方法
+			generateAccessor(&buff, parent, config)
+			generateLookup(&buff, parent, config)
 		} else {
 			// 非嵌套模式下生成所有结构体的访问函数
 			for _, a := range all {
-				generateAccessor(&buff, a)
+				generateAccessor(&buff, a, config)
+				generateLookup(&buff, a, config)
 			}
 		}
+		buff.WriteString(lookupSource)
+	}
+	typePaths := getTypePaths(parent, all)
+	for _, node := range enums {
+		typePaths[node.enum.name] = node.p
 	}
 	source, err := format.Source(buff.Bytes())
 	if err != nil {
-		fmt.Println(err)
+		// 语法错误，定位到对应的json路径
+		if config.CheckFlag {
+			if diagnostics := checkSource(buff.Bytes(), typePaths); len(diagnostics) > 0 {
+				err = &CheckError{Diagnostics: diagnostics}
+			}
+		}
 		return err.Error(), err
 	}
-	return string(source), nil
-}
-
-func generateAccessor(buff *bytes.Buffer, node *Node) {
-	if node.formattedName == "" {
-		return
-	}
-	buff.WriteString(fmt.Sprintf("\nfunc (n *%s) GetField(fieldName string) interface{} {\n", node.formattedName))
-	buff.WriteString("    switch fieldName {\n")
-	for _, child := range *node.children {
-		if child.formattedKey == "" {
-			continue
+	if config.CheckFlag {
+		// 类型检查有问题时，同时返回生成的代码
+		if diagnostics := checkSource(source, typePaths); len(diagnostics) > 0 {
+			return string(source), &CheckError{Diagnostics: diagnostics}
 		}
-		buff.WriteString(fmt.Sprintf("    case %q:\n        return n.%s\n", child.formattedKey, child.formattedKey))
 	}
-	buff.WriteString("    default:\n        return nil\n    }\n}\n")
+	return string(source), nil
 }
 
 // 新增Map生成函数
 func generateMap(jsonStr string, config *Config) (string, error) {
+	if maxDepth(config) > 0 {
+		if _, err := parseDepth([]byte(jsonStr), config); err != nil {
+			return "", err
+		}
+	}
 	var result interface{}
 	if err := json.Unmarshal([]byte(jsonStr), &result); err != nil {
 		return "", err
@@ -235,28 +422,28 @@ func generateMap(jsonStr string, config *Config) (string, error) {
 
 	var buff bytes.Buffer
 	buff.WriteString("var generatedMap = ")
-	generateMapValue(&buff, result, 0)
+	generateMapValue(&buff, result, 0, anyType(config))
 	source, _ := format.Source(buff.Bytes())
 	return string(source), nil
 }
 
 // 递归生成Map结构
-func generateMapValue(buff *bytes.Buffer, value interface{}, indent int) {
+func generateMapValue(buff *bytes.Buffer, value interface{}, indent int, typeAny string) {
 	switch v := value.(type) {
 	case map[string]interface{}:
-		buff.WriteString("map[string]interface{}{\n")
+		buff.WriteString("map[string]" + typeAny + "{\n")
 		for key, val := range v {
 			buff.WriteString(strings.Repeat("    ", indent+1))
 			fmt.Fprintf(buff, "%q: ", key)
-			generateMapValue(buff, val, indent+1)
+			generateMapValue(buff, val, indent+1, typeAny)
 			buff.WriteString(",\n")
 		}
 		buff.WriteString(strings.Repeat("    ", indent) + "}")
 	case []interface{}:
-		buff.WriteString("[]interface{}{\n")
+		buff.WriteString("[]" + typeAny + "{\n")
 		for _, item := range v {
 			buff.WriteString(strings.Repeat("    ", indent+1))
-			generateMapValue(buff, item, indent+1)
+			generateMapValue(buff, item, indent+1, typeAny)
 			buff.WriteString(",\n")
 		}
 		buff.WriteString(strings.Repeat("    ", indent) + "}")
@@ -285,19 +472,19 @@ func NewNode(k, t, g, c string) *Node {
 	return node
 }
 
+// 使用栈遍历，合并每一层的节点，嵌套很深时不会栈溢出
 func mergeArrayNode(parent *Node) {
-	for _, node := range *parent.childrenMerge {
-		addChildren(parent, walkNode(node))
-	}
-}
-
-// nodes是一个属性
-func walkNode(nodes []*Node) *Node {
-	parent := mergeNode(nodes)
-	for _, node := range *parent.childrenMerge {
-		addChildren(parent, walkNode(node))
+	stack := []*Node{parent}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		// nodes是一个属性
+		for _, nodes := range *node.childrenMerge {
+			child := mergeNode(nodes)
+			addChildren(node, child)
+			stack = append(stack, child)
+		}
 	}
-	return parent
 }
 
 func mergeNode(nodes []*Node) *Node {
@@ -306,6 +493,12 @@ func mergeNode(nodes []*Node) *Node {
 	n.g = group
 	n.t = t
 	n.c = mergeComment(nodes)
+	mergePositionComment(n, nodes)
+	mergeCommentValues(n, nodes)
+	for _, node := range nodes {
+		mergeEnumValues(n, node.enum)
+	}
+	n.null = len(removeNull(nodes)) < len(nodes)
 	for _, node := range nodes {
 		for _, n1 := range *node.childrenMerge {
 			for _, n2 := range n1 {
@@ -316,29 +509,32 @@ func mergeNode(nodes []*Node) *Node {
 	return n
 }
 
-func setJsonTag(config *Config) {
-	flag := false
+// 返回包含json tag的配置，需要添加时复制一份，不修改调用方的Config，多个协程可以共用同一个Config
+func setJsonTag(config *Config) *Config {
 	for _, tag := range config.Tags {
 		if tag == DefaultTag {
-			flag = true
-			break
-		}
-	}
-	if !flag {
-		if config.Tags == nil {
-			config.Tags = make([]string, 0)
+			return config
 		}
-		config.Tags = append([]string{DefaultTag}, config.Tags...)
 	}
+	c := *config
+	c.Tags = append([]string{DefaultTag}, config.Tags...)
+	return &c
 }
 
-func recursionAdd(all *[]*Node, node *Node) {
-	// 支持没有属性的struct
-	if node.g == GroupO || node.g == GroupO1 || node.g == GroupO2 {
-		*all = append(*all, node)
-	}
-	for _, n := range *node.children {
-		recursionAdd(all, n)
+// 按照先序遍历的顺序收集所有的结构体，使用栈代替递归
+func collectStructs(all *[]*Node, node *Node) {
+	stack := []*Node{node}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		// 支持没有属性的struct
+		if n.g == GroupO || n.g == GroupO1 || n.g == GroupO2 {
+			*all = append(*all, n)
+		}
+		children := *n.children
+		for i := len(children) - 1; i >= 0; i-- {
+			stack = append(stack, children[i])
+		}
 	}
 }
 
@@ -350,24 +546,112 @@ func recursionWrite(parent *Node, config *Config) string {
 	var res bytes.Buffer
 	res.WriteString("struct {\n")
 	for _, node := range *parent.children {
-		if node.c != "" && config.Comment == Comment1 {
-			res.WriteString(node.c + "\n")
+		key := formatKey(nameMap, nameCount, node.k, config)
+		above, lineEnd := fieldComment(node, key, config)
+		if above != "" {
+			res.WriteString(above + "\n")
 		}
-		key := formatKey(nameMap, nameCount, node.k)
 		nestKey := key
-		if len(*node.children) > 0 {
+		// 没有属性的对象也使用匿名结构体
+		if len(*node.children) > 0 || isObject(node.g) {
 			nestKey = recursionWrite(node, config)
 		}
-		if node.c != "" && config.Comment == Comment2 {
-			res.WriteString(fmt.Sprintf("%s %s %s %s\n", key, formatType(nestKey, node.t, node.g, config.PointerFlag), formatTag(node.k, config.Tags), node.c))
+		node.formattedKey = key
+		node.formattedType = formatType(nestKey, node, config)
+		if lineEnd != "" {
+			res.WriteString(fmt.Sprintf("%s %s %s %s\n", key, node.formattedType, formatTag(node.k, node.o, config.Tags), lineEnd))
 		} else {
-			res.WriteString(fmt.Sprintf("%s %s %s\n", key, formatType(nestKey, node.t, node.g, config.PointerFlag), formatTag(node.k, config.Tags)))
+			res.WriteString(fmt.Sprintf("%s %s %s\n", key, node.formattedType, formatTag(node.k, node.o, config.Tags)))
 		}
 	}
+	writeEndComment(&res, parent, config)
 	res.WriteString("}")
 	return res.String()
 }
 
+// 返回字段上面和行尾的注释，key是格式化后的字段名
+func fieldComment(node *Node, key string, config *Config) (above string, lineEnd string) {
+	switch {
+	case config.Comment == Comment0:
+	case config.Comment == Comment3:
+		lineEnd = valueComment(node, config)
+	case config.CommentPositionFlag:
+		above, lineEnd = node.lc, node.tc
+		// 非嵌套模式下，对象前面的注释已经作为结构体的文档注释
+		if !config.NestFlag && node.g == GroupO {
+			above = ""
+		}
+		// 嵌套模式下匿名结构体没有文档注释，数组中对象前面的注释放在字段上面
+		if config.NestFlag && (node.g == GroupO1 || node.g == GroupO2) && node.dc != "" && node.dc != above {
+			if above != "" {
+				above += "\n"
+			}
+			above += node.dc
+		}
+	case config.Comment == Comment1:
+		above = node.c
+	case config.Comment == Comment2:
+		lineEnd = node.c
+	}
+	return renderComment(above, key, false, config), renderComment(lineEnd, key, true, config)
+}
+
+// 结构体的文档注释，嵌套模式下只有最外层的结构体
+func writeDocComment(buff *bytes.Buffer, node *Node, config *Config) {
+	if config.Comment != Comment0 && config.CommentPositionFlag && node.dc != "" {
+		buff.WriteString(renderComment(node.dc, node.formattedName, false, config) + "\n")
+	}
+}
+
+// 最后一个属性后面，结束括号前的注释
+func writeEndComment(buff *bytes.Buffer, node *Node, config *Config) {
+	if config.Comment != Comment0 && config.CommentPositionFlag && node.ec != "" {
+		buff.WriteString(renderComment(node.ec, "", false, config) + "\n")
+	}
+}
+
+// 将合并后的临时类型转换为最终的类型
+func recursionResolve(parent *Node, config *Config) {
+	for _, node := range *parent.children {
+		resolveNumber(node, config)
+		resolveQuoted(node, config)
+		resolveEnum(node, config)
+		resolveNullable(node, config)
+		recursionResolve(node, config)
+	}
+}
+
+// 字符串形式的数字和布尔值，",string"只对非数组的属性生效
+func resolveQuoted(node *Node, config *Config) {
+	switch node.t {
+	case TypeQuotedInt, TypeQuotedNegInt, TypeQuotedInt64, TypeQuotedNegInt64, TypeQuotedUint64, TypeQuotedFloat64, TypeQuotedOverflow, TypeQuotedBool:
+	default:
+		return
+	}
+	t := strings.Trim(node.t, `"`)
+	if config.Quoted == Quoted0 || node.g != GroupV {
+		node.t = TypeString
+		return
+	}
+	node.o = ",string"
+	if config.Quoted == Quoted2 && t != TypeBool {
+		node.t = TypeNumber
+		return
+	}
+	switch {
+	case t == TypeOverflow:
+		// 和数字一样按照Config.Overflow处理，*big.Int不支持",string"，使用json.Number
+		if config.Overflow == Overflow0 {
+			t = TypeFloat64
+		} else {
+			t = TypeNumber
+		}
+	case t != TypeBool:
+		t = numberPolicyType(t, config.NumberPolicy)
+	}
+	node.t = t
+}
+
 func mergeComment(nodes []*Node) string {
 	comment := ""
 	for _, p := range nodes {
@@ -379,10 +663,32 @@ func mergeComment(nodes []*Node) string {
 	return comment
 }
 
+// 按照位置区分的注释，和mergeComment一样取第一个
+func mergePositionComment(n *Node, nodes []*Node) {
+	for _, p := range nodes {
+		if n.lc == "" {
+			n.lc = p.lc
+		}
+		if n.tc == "" {
+			n.tc = p.tc
+		}
+		if n.ec == "" {
+			n.ec = p.ec
+		}
+		if n.dc == "" {
+			n.dc = p.dc
+		}
+	}
+}
+
 // 返回属性的类型，需要考虑大类型和小类型
 func mergeGroupAndType(array []*Node) (group string, t string) {
 	var groups []string
 	var types []string
+	// null不参与大类型的判断，只有null时除外
+	if values := removeNull(array); len(values) > 0 {
+		array = values
+	}
 	for _, p := range array {
 		groups = append(groups, p.g)
 		types = append(types, p.t)
@@ -486,17 +792,23 @@ func mergeFiledGroup(array []string) (bool, string) {
 	}
 }
 
-// 格式化对象名，属性名，并且通过cache来解决全局重名问题
-// 对象名不能重复；属性名要考虑多个对象之间的一致性，所以要保持全局统一的映射关系
-func formatKey(nameMap map[string]string, nameCount map[string]int, key string) string {
+// 格式化属性名，并且通过cache来解决同一个结构体内的重名问题
+func formatKey(nameMap map[string]string, nameCount map[string]int, key string, config *Config) string {
 	if e, ok := nameMap[key]; ok {
 		return e
 	}
+	result := uniqueName(nameCount, formatName(key, config))
+	nameMap[key] = result
+	return result
+}
+
+// 将json的属性名转换为go的名称
+func formatName(key string, config *Config) string {
 	result := ""
-	// 将驼峰式命名转换为下划线分割
-	newKey := convertToUnderline(key)
-	// 按下划线分割，每个片段的首字母大写
-	split := strings.Split(newKey, "_")
+	// 将驼峰式命名转换为下划线分割，非ASCII的文字先按照配置转换
+	newKey := convertToUnderline(transliterate(key, config), config)
+	// 按下划线分割，连在一起的小写单词按照词典再次分割，每个片段的首字母大写
+	split := splitWords(strings.Split(newKey, "_"), config)
 	for i, str := range split {
 		span := ""
 		for j, v := range str {
@@ -521,27 +833,35 @@ func formatKey(nameMap map[string]string, nameCount map[string]int, key string)
 			}
 			span += s
 		}
-		span = convertInitialisms(span)
+		span = convertInitialisms(span, config)
 		result += span
 	}
-	result = convertInitialisms(result)
-	// 判断是否重名
-	if count, ok := nameCount[result]; ok {
-		// 重名了，末尾加数字
-		result = result + strconv.Itoa(count+1)
-		nameCount[result] = count + 1
-	} else {
-		nameCount[result] = 0
+	result = convertInitialisms(result, config)
+	// 没有可以使用的字符时，保证名称不为空
+	if result == "" {
+		return FallbackName
+	}
+	// 小写开头的缩写在开头时需要大写，例如iOS转换为IOS，保证属性可以导出
+	if r, size := utf8.DecodeRuneInString(result); unicode.IsLower(r) {
+		result = string(unicode.ToUpper(r)) + result[size:]
 	}
-	nameMap[key] = result
 	return result
 }
 
-func convertToUnderline(key string) string {
+func convertToUnderline(key string, config *Config) string {
 	var buffer bytes.Buffer
 	runes := []rune(key)
 	length := len(runes)
-	for i, r := range runes {
+	for i := 0; i < length; i++ {
+		r := runes[i]
+		// 大小写混合的缩写作为一个单词，例如OAuth
+		if initialism := matchInitialism(runes[i:], config); initialism != "" {
+			buffer.WriteRune('_')
+			buffer.WriteString(initialism)
+			buffer.WriteRune('_')
+			i += len([]rune(initialism)) - 1
+			continue
+		}
 		if unicode.IsUpper(r) {
 			if i > 0 && i+1 < length && unicode.IsLower(runes[i+1]) {
 				buffer.WriteRune('_')
@@ -570,14 +890,23 @@ func convertToPinyin(s string) string {
 }
 
 // 格式化完整的类型
-func formatType(key string, t string, group string, pointerFlag bool) string {
+func formatType(key string, node *Node, config *Config) string {
+	t := node.t
+	if t == TypeAny {
+		t = anyType(config)
+	}
+	group := node.g
 	result := t
 	pointer := ""
-	if pointerFlag {
+	if config.PointerFlag {
 		pointer = "*"
 	}
 	if group == GroupO {
 		result = pointer + key
+		if node.null {
+			// 出现过null的对象
+			result = formatNullable(key, config)
+		}
 	} else if group == GroupO1 {
 		result = "[]" + pointer + key
 	} else if group == GroupO2 {
@@ -590,156 +919,190 @@ func formatType(key string, t string, group string, pointerFlag bool) string {
 	return result
 }
 
-// 格式化tag
-func formatTag(key string, tag []string) string {
+// 格式化tag，option只添加到json tag上
+func formatTag(key string, option string, tag []string) string {
 	result := "`"
 	var array []string
 	for _, t := range tag {
 		s := fmt.Sprintf("%s:%q", t, key)
+		if t == DefaultTag && option != "" {
+			s = fmt.Sprintf("%s:%q", t, key+option)
+		}
 		array = append(array, s)
 	}
-	result += strings.Join(array, " ")
+	tags := strings.Join(array, " ")
+	// 原始字符串不能包含反引号，使用双引号的字符串
+	if strings.Contains(tags, "`") {
+		return strconv.Quote(tags)
+	}
+	result += tags
 	result += "`"
 	return result
 }
 
-func recursionNode(parent *Node, data []byte, config *Config) error {
-	var err error
-	var group, t, c string
-	var arrayObj [][]byte
-	err = jsonparser.ObjectEach(data, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
-		group, err = getGroup(value, dataType)
-		if err != nil {
-			return false, err
-		}
+func recursionNode(parent *Node, object *jsonparser.Value, state *inferState) error {
+	config := state.config
+	if object.Type != jsonparser.Object {
+		return jsonparser.MalformedObjectError
+	}
+	if parent.ec == "" {
+		parent.ec = string(object.EndComment)
+	}
+	for _, value := range object.Children {
+		key := string(value.Key)
+		comment := string(value.Comment)
+		group := getGroup(value)
 		switch group {
 		case GroupV:
-			addChildrenMerge(parent, NewNode(string(key), getJSONType(value, dataType), group, string(comment)))
-		case GroupV1:
-			t, c, err = getJSONArrayType(value, 1)
-			if err != nil {
-				return false, err
+			node := newPositionNode(key, getJSONType(value.Data, value.Type), group, comment, value)
+			if config.Comment == Comment3 {
+				addCommentValue(node, value)
 			}
-			// 优先使用数组的注释，不存在时，在使用从属性里提取出来的注释
-			if len(comment) > 0 {
-				c = string(comment)
+			if config.EnumFlag {
+				addEnumValue(node, value, config)
 			}
-			addChildrenMerge(parent, NewNode(string(key), t, group, c))
-		case GroupV2:
-			t, c, err = getJSONArrayType(value, 2)
+			if err := state.addChild(parent, node); err != nil {
+				return err
+			}
+		case GroupV1, GroupV2:
+			count := 1
+			if group == GroupV2 {
+				count = 2
+			}
+			t, c, err := getJSONArrayType(value, count)
 			if err != nil {
-				return false, err
+				return err
 			}
+			// 优先使用数组的注释，不存在时，在使用从属性里提取出来的注释
 			if len(comment) > 0 {
-				c = string(comment)
+				c = comment
+			}
+			if err = state.addChild(parent, newPositionNode(key, t, group, c, value)); err != nil {
+				return err
 			}
-			addChildrenMerge(parent, NewNode(string(key), t, group, c))
 		case GroupO:
-			node := NewNode(string(key), string(key), group, string(comment))
-			addChildrenMerge(parent, node)
-			err = recursionNode(node, value, config)
-			if err != nil {
-				return false, err
+			node := newPositionNode(key, key, group, comment, value)
+			// 对象前面的注释作为结构体的文档注释
+			node.dc = node.lc
+			if err := state.addChild(parent, node); err != nil {
+				return err
 			}
-		case GroupO1:
-			arrayObj, c, err = getArrayObj(value, 1)
+			if err := recursionNode(node, value, state); err != nil {
+				return err
+			}
+		case GroupO1, GroupO2:
+			count := 1
+			if group == GroupO2 {
+				count = 2
+			}
+			arrayObj, c, err := getArrayObj(value, count)
 			if err != nil {
-				return false, err
+				return err
 			}
 			if len(comment) > 0 {
-				c = string(comment)
+				c = comment
 			}
 
-			node := NewNode(string(key), string(key), group, c)
-			addChildrenMerge(parent, node)
-
+			node := newPositionNode(key, key, group, c, value)
+			// 数组中对象前面的注释作为结构体的文档注释
 			for _, obj := range arrayObj {
-				err = recursionNode(node, obj, config)
-				if err != nil {
-					return false, err
+				if len(obj.LeadingComment) > 0 {
+					node.dc = string(obj.LeadingComment)
+					break
 				}
 			}
-		case GroupO2:
-			arrayObj, c, err = getArrayObj(value, 2)
-			if err != nil {
-				return false, err
-			}
-			if len(comment) > 0 {
-				c = string(comment)
+			if err = state.addChild(parent, node); err != nil {
+				return err
 			}
 
-			node := NewNode(string(key), string(key), group, c)
-			addChildrenMerge(parent, node)
-
-			for _, obj := range arrayObj {
-				err = recursionNode(node, obj, config)
-				if err != nil {
-					return false, err
-				}
+			if err = recursionNodes(node, arrayObj, state); err != nil {
+				return err
 			}
 		case GroupNil1:
-			addChildrenMerge(parent, NewNode(string(key), TypeNil, group, ""))
+			if err := state.addChild(parent, newPositionNode(key, TypeNil, group, "", value)); err != nil {
+				return err
+			}
 		case GroupNil2:
-			addChildrenMerge(parent, NewNode(string(key), TypeNil, group, ""))
+			if err := state.addChild(parent, newPositionNode(key, TypeNil, group, "", value)); err != nil {
+				return err
+			}
 		}
-		return true, nil
-	})
-	if err != nil {
-		return err
 	}
 	return nil
 }
 
-func getGroup(value []byte, dataType jsonparser.ValueType) (string, error) {
+// 创建节点，同时记录按照位置区分的注释
+func newPositionNode(k, t, g, c string, value *jsonparser.Value) *Node {
+	node := NewNode(k, t, g, c)
+	node.lc = string(value.LeadingComment)
+	node.tc = string(value.TrailingComment)
+	return node
+}
+
+// 根据数组的第一个元素判断分组，二维数组的第一个元素如果是空，继续判断
+func getGroup(value *jsonparser.Value) string {
+	switch value.Type {
+	case jsonparser.Object:
+		return GroupO
+	case jsonparser.Array:
+	default:
+		return GroupV
+	}
+	if len(value.Children) == 0 {
+		return GroupNil1
+	}
 	group := ""
-	var err error
-	if dataType == jsonparser.Array {
-		count1 := 0
-		// 二维数组的第一个元素如果是空，继续判断
-		nextFlag := true
-		err = jsonparser.ArrayEach(value, func(value2 []byte, dataType2 jsonparser.ValueType, offset2 int, comment []byte) (flag bool, err error) {
-			count1++
-			if nextFlag {
-				if dataType2 == jsonparser.Object {
-					group = GroupO1
-					nextFlag = false
-				} else if dataType2 == jsonparser.Array {
-					count2 := 0
-					err = jsonparser.ArrayEach(value2, func(value3 []byte, dataType3 jsonparser.ValueType, offset3 int, comment []byte) (flag bool, err error) {
-						count2++
-						nextFlag = false
-						if dataType3 == jsonparser.Object {
-							group = GroupO2
-						} else {
-							group = GroupV2
-						}
-						return false, nil
-					})
-					if err != nil {
-						return false, err
-					}
-					if count1 == 1 && count2 == 0 {
-						group = GroupNil2
-					}
+loop:
+	for i, v := range value.Children {
+		switch v.Type {
+		case jsonparser.Object:
+			group = GroupO1
+			break loop
+		case jsonparser.Array:
+			if len(v.Children) > 0 {
+				if v.Children[0].Type == jsonparser.Object {
+					group = GroupO2
 				} else {
-					group = GroupV1
-					nextFlag = false
+					group = GroupV2
 				}
+				break loop
 			}
-			return nextFlag, nil
-		})
-		if err != nil {
-			return "", err
+			if i == 0 {
+				group = GroupNil2
+			}
+		default:
+			return GroupV1
 		}
-		if count1 == 0 {
-			group = GroupNil1
+	}
+	// 后面的元素类型不一致时，作为普通数组处理，null不影响判断
+	switch group {
+	case GroupO1:
+		if !allType(value.Children, jsonparser.Object) {
+			return GroupV1
+		}
+	case GroupO2, GroupV2, GroupNil2:
+		if !allType(value.Children, jsonparser.Array) {
+			return GroupV1
+		}
+		if group == GroupO2 {
+			for _, v := range value.Children {
+				if !allType(v.Children, jsonparser.Object) {
+					return GroupV2
+				}
+			}
+		}
+	}
+	return group
+}
+
+// 除了null以外的元素是否都是t类型
+func allType(values []*jsonparser.Value, t jsonparser.ValueType) bool {
+	for _, v := range values {
+		if v.Type != t && v.Type != jsonparser.Null {
+			return false
 		}
-	} else if dataType == jsonparser.Object {
-		group = GroupO
-	} else {
-		group = GroupV
 	}
-	return group, nil
+	return true
 }
 
 func addChildren(parent *Node, node *Node) {
@@ -757,27 +1120,28 @@ func addChildrenMerge(parent *Node, node *Node) {
 }
 
 // 获取数组内所有的对象
-func getArrayObj(data []byte, count int) (result [][]byte, c string, err error) {
-	err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
+func getArrayObj(array *jsonparser.Value, count int) (result []*jsonparser.Value, c string, err error) {
+	for _, value := range array.Children {
+		// null的元素不需要推断
+		if value.Type == jsonparser.Null {
+			continue
+		}
 		if count == 1 {
 			result = append(result, value)
 		} else {
-			err = jsonparser.ArrayEach(value, func(value2 []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
-				result = append(result, value2)
-				return true, nil
-			})
-			if err != nil {
-				return false, err
+			if value.Type != jsonparser.Array {
+				return nil, c, jsonparser.MalformedArrayError
+			}
+			for _, v := range value.Children {
+				if v.Type != jsonparser.Null {
+					result = append(result, v)
+				}
 			}
 		}
 		// 注释只提取外层的
-		if c == "" && string(comment) != "" {
-			c = string(comment)
+		if c == "" && len(value.Comment) > 0 {
+			c = string(value.Comment)
 		}
-		return true, nil
-	})
-	if err != nil {
-		return nil, c, err
 	}
 	return result, c, nil
 }
@@ -786,23 +1150,25 @@ func getArrayObj(data []byte, count int) (result [][]byte, c string, err error)
 func mergeFiledType(array []string, flag bool) string {
 	stringFlag := false
 	boolFlag := false
-	float64Flag := false
-	intFlag := false
-	int64Flag := false
 	anyFlag := false
 	nilFlag := false
+	// 数字类型单独合并
+	var numbers []string
+	// 字符串形式的数字去掉引号后和数字一样合并
+	var quotedNumbers []string
+	quotedBoolFlag := false
 	for _, t := range array {
 		switch t {
+		case TypeQuotedInt, TypeQuotedNegInt, TypeQuotedInt64, TypeQuotedNegInt64, TypeQuotedUint64, TypeQuotedFloat64, TypeQuotedOverflow:
+			quotedNumbers = append(quotedNumbers, strings.Trim(t, `"`))
+		case TypeQuotedBool:
+			quotedBoolFlag = true
 		case TypeString:
 			stringFlag = true
 		case TypeBool:
 			boolFlag = true
-		case TypeFloat64:
-			float64Flag = true
-		case TypeInt:
-			intFlag = true
-		case TypeInt64:
-			int64Flag = true
+		case TypeFloat64, TypeInt, TypeNegInt, TypeInt64, TypeNegInt64, TypeUint64, TypeOverflow:
+			numbers = append(numbers, t)
 		case TypeAny:
 			anyFlag = true
 		case TypeNil:
@@ -812,12 +1178,20 @@ func mergeFiledType(array []string, flag bool) string {
 	if anyFlag {
 		return TypeAny
 	}
+	quotedNumberFlag := len(quotedNumbers) > 0
+	// 字符串形式的数字和布尔值，和普通字符串一起出现或者相互混合时，都作为普通字符串
+	if stringFlag || quotedNumberFlag && quotedBoolFlag {
+		stringFlag = stringFlag || quotedNumberFlag || quotedBoolFlag
+		quotedNumberFlag = false
+		quotedBoolFlag = false
+	}
 	count := 0
-	// 将这三种类型，统一合并为数字类型
-	if float64Flag || intFlag || int64Flag {
+	// 统一合并为数字类型
+	if len(numbers) > 0 {
 		count++
 	}
-	if stringFlag {
+	// json里都是字符串
+	if stringFlag || quotedNumberFlag || quotedBoolFlag {
 		count++
 	}
 	if boolFlag {
@@ -829,15 +1203,15 @@ func mergeFiledType(array []string, flag bool) string {
 	}
 	if stringFlag {
 		return TypeString
+	} else if quotedBoolFlag {
+		return TypeQuotedBool
+	} else if quotedNumberFlag {
+		// 优先级同数字类型，负数和uint64一起出现时同样使用overflow
+		return `"` + mergeNumberType(quotedNumbers) + `"`
 	} else if boolFlag {
 		return TypeBool
-	} else if float64Flag {
-		// 优先级  float64>int64>int
-		return TypeFloat64
-	} else if int64Flag {
-		return TypeInt64
-	} else if intFlag {
-		return TypeInt
+	} else if len(numbers) > 0 {
+		return mergeNumberType(numbers)
 	} else if nilFlag {
 		// 空类型优先级最低，这个地方返回空类型，为了后续类型合并使用
 		if flag {
@@ -849,60 +1223,55 @@ func mergeFiledType(array []string, flag bool) string {
 }
 
 // 合并数组内所有属性的类型
-func getJSONArrayType(data []byte, count int) (result string, c string, err error) {
+func getJSONArrayType(array *jsonparser.Value, count int) (result string, c string, err error) {
 	// 通过数组来推断类型
-	var array []string
-	err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
+	var types []string
+	for _, value := range array.Children {
 		if count == 1 {
-			jsonType := getJSONType(value, dataType)
-			array = append(array, jsonType)
-		} else {
-			err = jsonparser.ArrayEach(value, func(value2 []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
-				jsonType := getJSONType(value2, dataType)
-				array = append(array, jsonType)
-				return true, nil
-			})
-			if err != nil {
-				return false, err
+			types = append(types, getJSONType(value.Data, value.Type))
+		} else if value.Type != jsonparser.Null {
+			if value.Type != jsonparser.Array {
+				return "", c, jsonparser.MalformedArrayError
+			}
+			for _, v := range value.Children {
+				types = append(types, getJSONType(v.Data, v.Type))
 			}
 		}
 		// 注释只提取外层的
-		if c == "" && string(comment) != "" {
-			c = string(comment)
+		if c == "" && len(value.Comment) > 0 {
+			c = string(value.Comment)
 		}
-		return true, nil
-	})
-	if err != nil {
-		return "", c, err
 	}
-	return mergeFiledType(array, true), c, nil
+	return mergeFiledType(types, true), c, nil
 }
 
 // 获取json属性的类型
 func getJSONType(value []byte, t jsonparser.ValueType) string {
 	str := TypeAny
 	if t == jsonparser.Number {
-		str = TypeFloat64
-		v := string(value)
-		if !strings.Contains(v, ".") {
-			// 是整数
-			i, _ := strconv.Atoi(v)
-			if i >= MinInt32 && i <= MaxInt32 {
-				str = TypeInt
-			} else {
-				str = TypeInt64
-			}
-		}
+		str = getNumberType(value)
 	} else if t == jsonparser.Boolean {
 		str = TypeBool
 	} else if t == jsonparser.String {
-		str = TypeString
+		str = getQuotedType(value)
 	} else if t == jsonparser.Null {
 		str = TypeNil
 	}
 	return str
 }
 
+// 判断字符串的内容是否是数字或布尔值，例如"12345678901234567"、"true"
+func getQuotedType(value []byte) string {
+	if bytes.Equal(value, []byte("true")) || bytes.Equal(value, []byte("false")) {
+		return TypeQuotedBool
+	}
+	if !isJSONNumber(value) {
+		return TypeString
+	}
+	// 保留符号，和uint64合并时需要
+	return `"` + getNumberType(value) + `"`
+}
+
 func numToLetter(s string) string {
 	switch s {
 	case "0":
@@ -929,11 +1298,17 @@ func numToLetter(s string) string {
 	return s
 }
 
-func convertInitialisms(s string) string {
+func convertInitialisms(s string, config *Config) string {
 	upper := strings.ToUpper(s)
 	if _, ok := commonInitialisms[upper]; ok {
 		return upper
 	}
+	// 自定义的缩写，保持配置的大小写，例如OAuth
+	for _, initialism := range config.Initialisms {
+		if strings.ToUpper(initialism) == upper {
+			return initialism
+		}
+	}
 	return s
 }
 
@@ -943,3 +1318,43 @@ func isObject(group string) bool {
 	}
 	return false
 }
+
+// 合并同一个属性下分组和类型都相同的节点，流式读取时节点不会随记录数增长
+// 分组和类型的合并只和出现过的种类有关，注释取第一个，所以和直接合并的结果相同
+func compactNode(parent *Node) {
+	for i, nodes := range *parent.childrenMerge {
+		if len(nodes) > 1 {
+			nodes = compactNodes(nodes)
+			(*parent.childrenMerge)[i] = nodes
+		}
+		for _, node := range nodes {
+			compactNode(node)
+		}
+	}
+}
+
+func compactNodes(nodes []*Node) []*Node {
+	var result []*Node
+	index := make(map[[2]string]int)
+	for _, node := range nodes {
+		key := [2]string{node.g, node.t}
+		i, ok := index[key]
+		if !ok {
+			i = len(result)
+			index[key] = i
+			n := NewNode(node.k, node.t, node.g, "")
+			n.pid = node.pid
+			result = append(result, n)
+		}
+		appendCommentValues(result[i], node.e)
+		mergeEnumValues(result[i], node.enum)
+		for _, n1 := range *node.childrenMerge {
+			for _, n2 := range n1 {
+				addChildrenMerge(result[i], n2)
+			}
+		}
+	}
+	result[0].c = mergeComment(nodes)
+	mergePositionComment(result[0], nodes)
+	return result
+}
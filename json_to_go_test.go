@@ -1,8 +1,16 @@
 package core
 
 import (
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"go/types"
 	"json-to-go/jsonparser"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -514,6 +522,222 @@ type K4 struct {
 	// k3的注释
 	// k3的注释2
 	K3 int |json:"k3"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试按照位置生成注释",
+			args: args{
+				jsonStr: `// 用户信息
+{
+  "id": 1, // 编号
+  // 名称
+  "name": "a",
+  "profile": /* 资料 */ {
+    "age": 2 // 年龄
+    // 资料结束
+  },
+  "tags": [
+    // 标签
+    {"k": 1}
+  ]
+  // 用户结束
+}`,
+				config: &Config{
+					Comment:             Comment1,
+					CommentPositionFlag: true,
+				},
+			},
+			want: `// 用户信息
+type AutoGenerated struct {
+	ID int |json:"id"| // 编号
+	// 名称
+	Name    string  |json:"name"|
+	Profile Profile |json:"profile"|
+	Tags    []Tags  |json:"tags"|
+	// 用户结束
+}
+
+/* 资料 */
+type Profile struct {
+	Age int |json:"age"| // 年龄
+	// 资料结束
+}
+
+// 标签
+type Tags struct {
+	K int |json:"k"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试嵌套结构按照位置生成注释",
+			args: args{
+				jsonStr: `{
+  "profile": // 资料
+  {
+    "age": 2, /* 年龄 */
+    "city": "b"
+  }, // 资料的行尾注释
+  // 标签列表
+  "tags": [
+    // 标签
+    {"k": 1}
+  ]
+}`,
+				config: &Config{
+					Comment:             Comment2,
+					CommentPositionFlag: true,
+					NestFlag:            true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	// 资料
+	Profile struct {
+		Age  int    |json:"age"| /* 年龄 */
+		City string |json:"city"|
+	} |json:"profile"| // 资料的行尾注释
+	// 标签列表
+	// 标签
+	Tags []struct {
+		K int |json:"k"|
+	} |json:"tags"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试转换为go风格的注释",
+			args: args{
+				jsonStr: `/*
+ * 用户信息
+ */
+{
+  /* 用户编号，
+     注册时生成 */
+  "id": 1,
+  "name": "a" /* 名称 */
+}`,
+				config: &Config{
+					Comment:             Comment1,
+					CommentPositionFlag: true,
+					CommentStyle:        CommentStyle1,
+					CommentNameFlag:     true,
+				},
+			},
+			want: `// AutoGenerated 用户信息
+type AutoGenerated struct {
+	// ID 用户编号，
+	// 注册时生成
+	ID   int    |json:"id"|
+	Name string |json:"name"| // 名称
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试示例值注释",
+			args: args{
+				jsonStr: `{
+  "list": [
+    {"status": "open", "id": 1, "note": null},
+    {"status": "closed", "id": 2},
+    {"status": "open", "id": 3, "note": "x"},
+    {"status": "pending", "id": 4}
+  ],
+  "desc": "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+  "ok": true // 这个注释去掉
+}`,
+				config: &Config{
+					Comment:       Comment3,
+					CommentValues: 3,
+				},
+			},
+			want: `type AutoGenerated struct {
+	List []List |json:"list"|
+	Desc string |json:"desc"| // e.g. "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa..."
+	Ok   bool   |json:"ok"|   // e.g. true
+}
+
+type List struct {
+	Status string |json:"status"| // e.g. "open", "closed", "pending"
+	ID     int    |json:"id"|     // e.g. 1, 2, 3, ...
+	Note   string |json:"note"|   // e.g. "x"
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试推断枚举",
+			args: args{
+				jsonStr: `{
+  "items": [
+    {"status": "active", "level": 1, "name": "a"},
+    {"status": "in-progress", "level": 2, "name": "b"},
+    {"status": "active", "level": 1, "name": "c"},
+    {"status": "in-progress", "level": 2, "name": "d"}
+  ]
+}`,
+				config: &Config{
+					EnumFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Items []Items |json:"items"|
+}
+
+type Items struct {
+	Status ItemsStatus |json:"status"|
+	Level  ItemsLevel  |json:"level"|
+	Name   string      |json:"name"|
+}
+
+// ItemsStatus $.items[].status的取值
+type ItemsStatus string
+
+const (
+	ItemsStatusActive     ItemsStatus = "active"
+	ItemsStatusInProgress ItemsStatus = "in-progress"
+)
+
+// ItemsLevel $.items[].level的取值
+type ItemsLevel int
+
+const (
+	ItemsLevel1 ItemsLevel = 1
+	ItemsLevel2 ItemsLevel = 2
+)`,
+			wantErr: false,
+		},
+		{
+			name: "测试推断uint64的枚举",
+			args: args{
+				jsonStr: `[{"mask": 18446744073709551615}, {"mask": 1}, {"mask": 18446744073709551615}, {"mask": 1}]`,
+				config: &Config{
+					EnumFlag:       true,
+					EnumMethodFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Mask AutoGeneratedMask |json:"mask"|
+}
+
+// AutoGeneratedMask $.mask的取值
+type AutoGeneratedMask uint64
+
+const (
+	AutoGeneratedMask18446744073709551615 AutoGeneratedMask = 18446744073709551615
+	AutoGeneratedMask1                    AutoGeneratedMask = 1
+)
+
+// IsValid 是否是已知的值
+func (e AutoGeneratedMask) IsValid() bool {
+	switch e {
+	case AutoGeneratedMask18446744073709551615, AutoGeneratedMask1:
+		return true
+	}
+	return false
+}
+
+func (e AutoGeneratedMask) String() string {
+	return strconv.FormatUint(uint64(e), 10)
 }`,
 			wantErr: false,
 		},
@@ -556,155 +780,2398 @@ type K4 struct {
 			wantErr: false,
 		},
 		{
-			name: "web使用的测试case",
+			name: "测试字符串形式的数字和布尔值，使用,string",
 			args: args{
 				jsonStr: `{
-  // 支持中文key
-  "地址": "",
-  "doc_url": "http://localhost", // golint命名优化
-  // 重名会在末尾进行递增编号
-  "docUrl": "http://localhost",
-  // 整形数字，小于等于int32设置为int，大于int32设置为int64
-  "int1": 1,
-  "int2": 3000000000,
-  // 浮点型数字，全部设置为float64
-  "float": 1.15,
-  // 如果是数组，会合并对象的属性和属性类型
-  "a": [
-    [{
-      // 这个a1对象属性不全，会合并数组内其他a1对象的属性
-      "a1": {
-        "a2": [{
-          "a3": "123" // 类型不同，判断为interface{}
-        }, {
-          "b3": ""
-        }]
-      }
-    }, {
-      "a1": {
-        "a2": [{
-          "a3": 123 // 类型不同，判断为interface{}
-        }, {
-          "c3": ""
-        }],
-        "b2": ""
-      },
-      "b1": {
-        "b2": ""
-      }
-    }]
-  ]
+  "id": "12345678901234567",
+  "count": "12",
+  "flag": "true",
+  "zip": "007",
+  "items": [
+    {
+      "amount": "10",
+      "code": "1"
+    },
+    {
+      "amount": "10.5",
+      "code": "a"
+    }
+  ],
+  "ids": ["1", "2"]
 }`,
 				config: &Config{
-					Comment: Comment1,
+					Quoted: Quoted1,
 				},
 			},
 			want: `type AutoGenerated struct {
-	// 支持中文key
-	Dz string |json:"地址"|
-	// golint命名优化
-	DocURL string |json:"doc_url"|
-	// 重名会在末尾进行递增编号
-	DocURL1 string |json:"docUrl"|
-	// 整形数字，小于等于int32设置为int，大于int32设置为int64
-	Int1 int   |json:"int1"|
-	Int2 int64 |json:"int2"|
-	// 浮点型数字，全部设置为float64
-	Float float64 |json:"float"|
-	// 如果是数组，会合并对象的属性和属性类型
-	A [][]A |json:"a"|
-}
-
-type A struct {
-	// 这个a1对象属性不全，会合并数组内其他a1对象的属性
-	A1 A1 |json:"a1"|
-	B1 B1 |json:"b1"|
-}
-
-type A1 struct {
-	A2 []A2   |json:"a2"|
-	B2 string |json:"b2"|
-}
-
-type A2 struct {
-	// 类型不同，判断为interface{}
-	A3 interface{} |json:"a3"|
-	B3 string      |json:"b3"|
-	C3 string      |json:"c3"|
+	ID    int64    |json:"id,string"|
+	Count int      |json:"count,string"|
+	Flag  bool     |json:"flag,string"|
+	Zip   string   |json:"zip"|
+	Items []Items  |json:"items"|
+	Ids   []string |json:"ids"|
 }
 
-type B1 struct {
-	B2 string |json:"b2"|
+type Items struct {
+	Amount float64 |json:"amount,string"|
+	Code   string  |json:"code"|
 }`,
 			wantErr: false,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tt.want = strings.ReplaceAll(tt.want, "|", "`")
-			got, err := Generate(tt.args.jsonStr, tt.args.config)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("Generate() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("Generate() got = %s, want %s", got, tt.want)
-			}
-		})
-	}
-}
-
-func Test_getJSONType(t *testing.T) {
-	type args struct {
-		value []byte
-		t     jsonparser.ValueType
-	}
-	tests := []struct {
-		name string
-		args args
-		want string
-	}{
 		{
+			name: "测试字符串形式的数字，使用json.Number",
 			args: args{
-				value: []byte("10.5"),
-				t:     jsonparser.Number,
+				jsonStr: `{
+  "id": "12345678901234567",
+  "flag": "false",
+  "ids": ["1", "2.5"]
+}`,
+				config: &Config{
+					Quoted: Quoted2,
+					Tags:   []string{"bson"},
+				},
 			},
-			want: TypeFloat64,
+			want: `type AutoGenerated struct {
+	ID   json.Number |json:"id,string" bson:"id"|
+	Flag bool        |json:"flag,string" bson:"flag"|
+	Ids  []string    |json:"ids" bson:"ids"|
+}`,
+			wantErr: false,
 		},
 		{
+			name: "测试字符串形式的超出范围的整数",
 			args: args{
-				value: []byte("10.0"),
-				t:     jsonparser.Number,
+				jsonStr: `{
+  "big": "123456789012345678901234567890",
+  "mixed": ["1", "123456789012345678901234567890"]
+}`,
+				config: &Config{
+					Quoted:   Quoted1,
+					Overflow: Overflow1,
+				},
 			},
-			want: TypeFloat64,
+			want: `type AutoGenerated struct {
+	Big   json.Number |json:"big,string"|
+	Mixed []string    |json:"mixed"|
+}`,
+			wantErr: false,
 		},
 		{
+			name: "测试字符串形式的负数和uint64",
 			args: args{
-				value: []byte("0.0"),
-				t:     jsonparser.Number,
+				jsonStr: `[{"a": "-5", "b": "-5"}, {"a": "18446744073709551615", "b": "3000000000"}]`,
+				config: &Config{
+					Quoted: Quoted1,
+				},
 			},
-			want: TypeFloat64,
+			want: `type AutoGenerated struct {
+	A float64 |json:"a,string"|
+	B int64   |json:"b,string"|
+}`,
+			wantErr: false,
 		},
 		{
+			name: "测试超出范围的数字",
 			args: args{
-				value: []byte("100"),
-				t:     jsonparser.Number,
+				jsonStr: `{
+  "negative": -1,
+  "exp": 1e10,
+  "uint": 18446744073709551615,
+  "mixed": [-1, 18446744073709551615],
+  "big": 123456789012345678901234567890
+}`,
+				config: &Config{
+					Overflow: Overflow2,
+				},
 			},
-			want: TypeInt,
+			want: `type AutoGenerated struct {
+	Negative int        |json:"negative"|
+	Exp      float64    |json:"exp"|
+	Uint     uint64     |json:"uint"|
+	Mixed    []*big.Int |json:"mixed"|
+	Big      *big.Int   |json:"big"|
+}`,
+			wantErr: false,
 		},
 		{
+			name: "测试超出范围的数字使用json.Number",
 			args: args{
-				value: []byte("3000000000"),
-				t:     jsonparser.Number,
+				jsonStr: `{
+  "big": 123456789012345678901234567890,
+  "float": [1.5, 123456789012345678901234567890]
+}`,
+				config: &Config{
+					Overflow: Overflow1,
+				},
 			},
-			want: TypeInt64,
+			want: `type AutoGenerated struct {
+	Big   json.Number |json:"big"|
+	Float []float64   |json:"float"|
+}`,
+			wantErr: false,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := getJSONType(tt.args.value, tt.args.t); got != tt.want {
-				t.Errorf("getJSONType() = %v, want %v", got, tt.want)
-			}
-		})
+		{
+			name: "测试数字类型策略，整数统一使用int64",
+			args: args{
+				jsonStr: `{
+  "int": 1,
+  "int64": 3000000000,
+  "negative": [1, -1],
+  "float": [1, 2.5],
+  "id": "12"
+}`,
+				config: &Config{
+					NumberPolicy: Number1,
+					Quoted:       Quoted1,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Int      int64     |json:"int"|
+	Int64    int64     |json:"int64"|
+	Negative []int64   |json:"negative"|
+	Float    []float64 |json:"float"|
+	ID       int64     |json:"id,string"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试数字类型策略，统一使用float64",
+			args: args{
+				jsonStr: `{
+  "int": 1,
+  "int64": 3000000000,
+  "negative": [1, -1],
+  "float": [1, 2.5],
+  "id": "12"
+}`,
+				config: &Config{
+					NumberPolicy: Number2,
+					Quoted:       Quoted1,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Int      float64   |json:"int"|
+	Int64    float64   |json:"int64"|
+	Negative []float64 |json:"negative"|
+	Float    []float64 |json:"float"|
+	ID       float64   |json:"id,string"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试数字类型策略，没有负数时使用无符号类型",
+			args: args{
+				jsonStr: `{
+  "int": 1,
+  "int64": 3000000000,
+  "negative": [1, -1],
+  "float": [1, 2.5],
+  "id": "12",
+  "offset": "-12"
+}`,
+				config: &Config{
+					NumberPolicy: Number3,
+					Quoted:       Quoted1,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Int      uint      |json:"int"|
+	Int64    uint64    |json:"int64"|
+	Negative []int     |json:"negative"|
+	Float    []float64 |json:"float"|
+	ID       uint      |json:"id,string"|
+	Offset   int       |json:"offset,string"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试出现过null的属性",
+			args: args{
+				jsonStr: `[
+  {
+    "name": "a",
+    "age": 1,
+    "score": 1.5,
+    "vip": true,
+    "address": {
+      "city": ""
+    },
+    "tags": ["a"],
+    "other": null
+  },
+  {
+    "name": null,
+    "age": null,
+    "score": null,
+    "vip": null,
+    "address": null,
+    "tags": null,
+    "other": null
+  }
+]`,
+				config: &Config{},
+			},
+			want: `type AutoGenerated struct {
+	Name    string      |json:"name"|
+	Age     int         |json:"age"|
+	Score   float64     |json:"score"|
+	Vip     bool        |json:"vip"|
+	Address Address     |json:"address"|
+	Tags    []string    |json:"tags"|
+	Other   interface{} |json:"other"|
+}
+
+type Address struct {
+	City string |json:"city"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试出现过null的属性使用指针",
+			args: args{
+				jsonStr: `[
+  {
+    "name": "a",
+    "age": 1,
+    "score": 1.5,
+    "vip": true,
+    "address": {
+      "city": ""
+    },
+    "tags": ["a"],
+    "other": null
+  },
+  {
+    "name": null,
+    "age": null,
+    "score": null,
+    "vip": null,
+    "address": null,
+    "tags": null,
+    "other": null
+  }
+]`,
+				config: &Config{
+					Nullable: Nullable1,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Name    *string     |json:"name"|
+	Age     *int        |json:"age"|
+	Score   *float64    |json:"score"|
+	Vip     *bool       |json:"vip"|
+	Address *Address    |json:"address"|
+	Tags    []string    |json:"tags"|
+	Other   interface{} |json:"other"|
+}
+
+type Address struct {
+	City string |json:"city"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试出现过null的超出范围的整数使用指针",
+			args: args{
+				jsonStr: `[{"big": 123456789012345678901234567890}, {"big": null}]`,
+				config: &Config{
+					Nullable: Nullable1,
+					Overflow: Overflow2,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Big *big.Int |json:"big"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试出现过null的超出范围的整数使用包装sql.Null*的类型",
+			args: args{
+				jsonStr: `[{"big": 123456789012345678901234567890}, {"big": null}]`,
+				config: &Config{
+					Nullable: Nullable2,
+					Overflow: Overflow2,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Big *big.Int |json:"big"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试出现过null的属性使用包装sql.Null*的类型",
+			args: args{
+				jsonStr: `[
+  {
+    "name": "a",
+    "age": 1,
+    "score": 1.5,
+    "vip": true,
+    "address": {
+      "city": ""
+    },
+    "tags": ["a"],
+    "other": null
+  },
+  {
+    "name": null,
+    "age": null,
+    "score": null,
+    "vip": null,
+    "address": null,
+    "tags": null,
+    "other": null
+  }
+]`,
+				config: &Config{
+					Nullable: Nullable2,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Name    NullString  |json:"name"|
+	Age     NullInt64   |json:"age"|
+	Score   NullFloat64 |json:"score"|
+	Vip     NullBool    |json:"vip"|
+	Address *Address    |json:"address"|
+	Tags    []string    |json:"tags"|
+	Other   interface{} |json:"other"|
+}
+
+type Address struct {
+	City string |json:"city"|
+}
+
+// NullString 可以为null的string，同时支持database/sql和encoding/json
+type NullString struct {
+	sql.NullString
+}
+
+func (n NullString) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.String)
+}
+
+func (n *NullString) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.NullString = sql.NullString{}
+		return nil
+	}
+	n.Valid = true
+	return json.Unmarshal(data, &n.String)
+}
+
+// NullBool 可以为null的bool，同时支持database/sql和encoding/json
+type NullBool struct {
+	sql.NullBool
+}
+
+func (n NullBool) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Bool)
+}
+
+func (n *NullBool) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.NullBool = sql.NullBool{}
+		return nil
+	}
+	n.Valid = true
+	return json.Unmarshal(data, &n.Bool)
+}
+
+// NullFloat64 可以为null的float64，同时支持database/sql和encoding/json
+type NullFloat64 struct {
+	sql.NullFloat64
+}
+
+func (n NullFloat64) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Float64)
+}
+
+func (n *NullFloat64) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.NullFloat64 = sql.NullFloat64{}
+		return nil
+	}
+	n.Valid = true
+	return json.Unmarshal(data, &n.Float64)
+}
+
+// NullInt64 可以为null的int64，同时支持database/sql和encoding/json
+type NullInt64 struct {
+	sql.NullInt64
+}
+
+func (n NullInt64) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Int64)
+}
+
+func (n *NullInt64) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.NullInt64 = sql.NullInt64{}
+		return nil
+	}
+	n.Valid = true
+	return json.Unmarshal(data, &n.Int64)
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试出现过null的属性使用Optional",
+			args: args{
+				jsonStr: `[
+  {
+    "name": "a",
+    "age": 1,
+    "score": 1.5,
+    "vip": true,
+    "address": {
+      "city": ""
+    },
+    "tags": ["a"],
+    "other": null
+  },
+  {
+    "name": null,
+    "age": null,
+    "score": null,
+    "vip": null,
+    "address": null,
+    "tags": null,
+    "other": null
+  }
+]`,
+				config: &Config{
+					Nullable:  Nullable3,
+					NestFlag:  true,
+					GoVersion: "1.18",
+				},
+			},
+			want: `type AutoGenerated struct {
+	Name    Optional[string]  |json:"name"|
+	Age     Optional[int]     |json:"age"|
+	Score   Optional[float64] |json:"score"|
+	Vip     Optional[bool]    |json:"vip"|
+	Address Optional[struct {
+		City string |json:"city"|
+	}] |json:"address"|
+	Tags  []string |json:"tags"|
+	Other any      |json:"other"|
+}
+
+// Optional 可以为null的值，Valid为false时表示null
+type Optional[T any] struct {
+	Value T
+	Valid bool
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.Value)
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		var zero T
+		o.Value, o.Valid = zero, false
+		return nil
+	}
+	o.Valid = true
+	return json.Unmarshal(data, &o.Value)
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试go1.18以上使用any",
+			args: args{
+				jsonStr: `{
+  "a": [],
+  "b": null
+}`,
+				config: &Config{
+					GoVersion:    "go1.18",
+					AccessorFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	A []any |json:"a"|
+	B any   |json:"b"|
+}
+
+func (n *AutoGenerated) GetA() []any {
+	if n != nil {
+		return n.A
+	}
+	return nil
+}
+
+func (n *AutoGenerated) GetB() any {
+	if n != nil {
+		return n.B
+	}
+	return nil
+}
+
+func (n *AutoGenerated) Lookup(path string) (any, bool) {
+	v, err := n.lookup(path, path)
+	return v, err == nil
+}
+
+func (n *AutoGenerated) LookupPath(path string) (any, error) {
+	return n.lookup(path, path)
+}
+
+func (n *AutoGenerated) lookup(full, path string) (any, error) {
+	if n == nil {
+		return nil, &FieldNotFoundError{Path: full}
+	}
+	if path == "" {
+		return n, nil
+	}
+	key, rest := lookupKey(path)
+	switch key {
+	case "a":
+		if rest == "" {
+			return n.A, nil
+		}
+		i, rest, err := lookupIndex(full, rest, len(n.A))
+		if err != nil {
+			return nil, err
+		}
+		if rest == "" {
+			return n.A[i], nil
+		}
+		return nil, &FieldNotFoundError{Path: full}
+	case "b":
+		if rest == "" {
+			return n.B, nil
+		}
+		return nil, &FieldNotFoundError{Path: full}
+	}
+	return nil, &FieldNotFoundError{Path: full}
+}
+
+// FieldNotFoundError Lookup的路径中属性不存在
+type FieldNotFoundError struct {
+	Path string
+}
+
+func (e *FieldNotFoundError) Error() string {
+	return "field not found: " + e.Path
+}
+
+// IndexOutOfRangeError Lookup的路径中数组下标越界
+type IndexOutOfRangeError struct {
+	Path  string
+	Index int
+	Len   int
+}
+
+func (e *IndexOutOfRangeError) Error() string {
+	return "index out of range [" + strconv.Itoa(e.Index) + "] with length " + strconv.Itoa(e.Len) + ": " + e.Path
+}
+
+// lookupKey 拆分路径，返回第一个属性名和剩余的路径
+func lookupKey(path string) (string, string) {
+	path = strings.TrimPrefix(path, ".")
+	i := strings.IndexAny(path, ".[")
+	if i == -1 {
+		return path, ""
+	}
+	return path[:i], path[i:]
+}
+
+// lookupIndex 解析路径开头的数组下标，例如[2]，返回下标和剩余的路径
+func lookupIndex(full, path string, length int) (int, string, error) {
+	if !strings.HasPrefix(path, "[") {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	end := strings.IndexByte(path, ']')
+	if end == -1 {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	i, err := strconv.Atoi(path[1:end])
+	if err != nil {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	// 负数转换为uint后一定越界
+	if uint(i) >= uint(length) {
+		return 0, "", &IndexOutOfRangeError{Path: full, Index: i, Len: length}
+	}
+	return i, path[end+1:], nil
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试go1.18以下不使用泛型",
+			args: args{
+				jsonStr: `[{"a": 1, "b": null}, {"a": null, "b": null}]`,
+				config: &Config{
+					GoVersion: "1.17",
+					Nullable:  Nullable3,
+				},
+			},
+			want: `type AutoGenerated struct {
+	A *int        |json:"a"|
+	B interface{} |json:"b"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试结构体重名时末尾加数字，属性名只在结构体内唯一",
+			args: args{
+				jsonStr: `{"order": {"item": {"a": 1}}, "invoice": {"item": {"b": 2}, "data": {"item": {"c": 3}}}, "Data": 1, "data": 2}`,
+				config: &Config{
+					NameStrategy: Name0,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Order   Order   |json:"order"|
+	Invoice Invoice |json:"invoice"|
+	Data    int     |json:"Data"|
+	Data1   int     |json:"data"|
+}
+
+type Order struct {
+	Item Item |json:"item"|
+}
+
+type Item struct {
+	A int |json:"a"|
+}
+
+type Invoice struct {
+	Item Item1 |json:"item"|
+	Data Data  |json:"data"|
+}
+
+type Item1 struct {
+	B int |json:"b"|
+}
+
+type Data struct {
+	Item Item2 |json:"item"|
+}
+
+type Item2 struct {
+	C int |json:"c"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试结构体名称加上父级属性名",
+			args: args{
+				jsonStr: `{"order": {"item": {"a": 1}}, "invoice": {"item": {"b": 2}, "data": {"item": {"c": 3}}}, "Data": 1, "data": 2}`,
+				config: &Config{
+					NameStrategy: Name1,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Order   Order   |json:"order"|
+	Invoice Invoice |json:"invoice"|
+	Data    int     |json:"Data"|
+	Data1   int     |json:"data"|
+}
+
+type Order struct {
+	Item OrderItem |json:"item"|
+}
+
+type OrderItem struct {
+	A int |json:"a"|
+}
+
+type Invoice struct {
+	Item InvoiceItem |json:"item"|
+	Data InvoiceData |json:"data"|
+}
+
+type InvoiceItem struct {
+	B int |json:"b"|
+}
+
+type InvoiceData struct {
+	Item DataItem |json:"item"|
+}
+
+type DataItem struct {
+	C int |json:"c"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试结构体名称使用完整的json路径",
+			args: args{
+				jsonStr: `{"order": {"item": {"a": 1}}, "invoice": {"item": {"b": 2}, "data": {"item": {"c": 3}}}, "Data": 1, "data": 2}`,
+				config: &Config{
+					NameStrategy: Name2,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Order   Order   |json:"order"|
+	Invoice Invoice |json:"invoice"|
+	Data    int     |json:"Data"|
+	Data1   int     |json:"data"|
+}
+
+type Order struct {
+	Item OrderItem |json:"item"|
+}
+
+type OrderItem struct {
+	A int |json:"a"|
+}
+
+type Invoice struct {
+	Item InvoiceItem |json:"item"|
+	Data InvoiceData |json:"data"|
+}
+
+type InvoiceItem struct {
+	B int |json:"b"|
+}
+
+type InvoiceData struct {
+	Item InvoiceDataItem |json:"item"|
+}
+
+type InvoiceDataItem struct {
+	C int |json:"c"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试数组内的对象使用单数作为结构体名称",
+			args: args{
+				jsonStr: `{"items": [{"a": 1}], "people": [[{"b": 2}]], "data": [{"c": 3}]}`,
+				config: &Config{
+					SingularFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Items  []Item     |json:"items"|
+	People [][]Person |json:"people"|
+	Data   []Data     |json:"data"|
+}
+
+type Item struct {
+	A int |json:"a"|
+}
+
+type Person struct {
+	B int |json:"b"|
+}
+
+type Data struct {
+	C int |json:"c"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试结构体名称和生成的类型重复",
+			args: args{
+				jsonStr: `{"optional": {"a": 1, "b": null}, "example": {"c": [1]}}`,
+				config: &Config{
+					Nullable:    Nullable3,
+					ExampleFlag: true,
+					GoVersion:   "1.18",
+				},
+			},
+			want: `type AutoGenerated struct {
+	Optional Optional_ |json:"optional"|
+	Example  Example_  |json:"example"|
+}
+
+type Optional_ struct {
+	A int |json:"a"|
+	B any |json:"b"|
+}
+
+type Example_ struct {
+	C []int |json:"c"|
+}
+
+var Example = AutoGenerated{
+	Optional: Optional_{
+		A: 1,
+	},
+	Example: Example_{
+		C: []int{
+			1,
+		},
+	},
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试生成Get和Set方法",
+			args: args{
+				jsonStr: `[{
+  "id": 1,
+  "getId": "",
+  "customer": {
+    "address": {
+      "city": ""
+    }
+  },
+  "items": [{"price": 1.5}]
+}, {
+  "id": null
+}]`,
+				config: &Config{
+					AccessorFlag: true,
+					SetterFlag:   true,
+					Nullable:     Nullable3,
+					GoVersion:    "1.18",
+				},
+			},
+			want: `type AutoGenerated struct {
+	ID       Optional[int] |json:"id"|
+	GetID    string        |json:"getId"|
+	Customer Customer      |json:"customer"|
+	Items    []Items       |json:"items"|
+}
+
+type Customer struct {
+	Address Address |json:"address"|
+}
+
+type Address struct {
+	City string |json:"city"|
+}
+
+type Items struct {
+	Price float64 |json:"price"|
+}
+
+// Optional 可以为null的值，Valid为false时表示null
+type Optional[T any] struct {
+	Value T
+	Valid bool
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.Value)
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		var zero T
+		o.Value, o.Valid = zero, false
+		return nil
+	}
+	o.Valid = true
+	return json.Unmarshal(data, &o.Value)
+}
+
+func (n *AutoGenerated) SetID(v Optional[int]) {
+	n.ID = v
+}
+
+func (n *AutoGenerated) GetGetID() string {
+	if n != nil {
+		return n.GetID
+	}
+	return ""
+}
+
+func (n *AutoGenerated) SetGetID(v string) {
+	n.GetID = v
+}
+
+func (n *AutoGenerated) GetCustomer() *Customer {
+	if n != nil {
+		return &n.Customer
+	}
+	return nil
+}
+
+func (n *AutoGenerated) SetCustomer(v Customer) {
+	n.Customer = v
+}
+
+func (n *AutoGenerated) GetItems() []Items {
+	if n != nil {
+		return n.Items
+	}
+	return nil
+}
+
+func (n *AutoGenerated) SetItems(v []Items) {
+	n.Items = v
+}
+
+func (n *AutoGenerated) Lookup(path string) (any, bool) {
+	v, err := n.lookup(path, path)
+	return v, err == nil
+}
+
+func (n *AutoGenerated) LookupPath(path string) (any, error) {
+	return n.lookup(path, path)
+}
+
+func (n *AutoGenerated) lookup(full, path string) (any, error) {
+	if n == nil {
+		return nil, &FieldNotFoundError{Path: full}
+	}
+	if path == "" {
+		return n, nil
+	}
+	key, rest := lookupKey(path)
+	switch key {
+	case "id":
+		if rest == "" {
+			return n.ID, nil
+		}
+		return nil, &FieldNotFoundError{Path: full}
+	case "getId":
+		if rest == "" {
+			return n.GetID, nil
+		}
+		return nil, &FieldNotFoundError{Path: full}
+	case "customer":
+		if rest == "" {
+			return n.Customer, nil
+		}
+		return n.Customer.lookup(full, rest)
+	case "items":
+		if rest == "" {
+			return n.Items, nil
+		}
+		i, rest, err := lookupIndex(full, rest, len(n.Items))
+		if err != nil {
+			return nil, err
+		}
+		if rest == "" {
+			return n.Items[i], nil
+		}
+		return n.Items[i].lookup(full, rest)
+	}
+	return nil, &FieldNotFoundError{Path: full}
+}
+
+func (n *Customer) GetAddress() *Address {
+	if n != nil {
+		return &n.Address
+	}
+	return nil
+}
+
+func (n *Customer) SetAddress(v Address) {
+	n.Address = v
+}
+
+func (n *Customer) Lookup(path string) (any, bool) {
+	v, err := n.lookup(path, path)
+	return v, err == nil
+}
+
+func (n *Customer) LookupPath(path string) (any, error) {
+	return n.lookup(path, path)
+}
+
+func (n *Customer) lookup(full, path string) (any, error) {
+	if n == nil {
+		return nil, &FieldNotFoundError{Path: full}
+	}
+	if path == "" {
+		return n, nil
+	}
+	key, rest := lookupKey(path)
+	switch key {
+	case "address":
+		if rest == "" {
+			return n.Address, nil
+		}
+		return n.Address.lookup(full, rest)
+	}
+	return nil, &FieldNotFoundError{Path: full}
+}
+
+func (n *Address) GetCity() string {
+	if n != nil {
+		return n.City
+	}
+	return ""
+}
+
+func (n *Address) SetCity(v string) {
+	n.City = v
+}
+
+func (n *Address) Lookup(path string) (any, bool) {
+	v, err := n.lookup(path, path)
+	return v, err == nil
+}
+
+func (n *Address) LookupPath(path string) (any, error) {
+	return n.lookup(path, path)
+}
+
+func (n *Address) lookup(full, path string) (any, error) {
+	if n == nil {
+		return nil, &FieldNotFoundError{Path: full}
+	}
+	if path == "" {
+		return n, nil
+	}
+	key, rest := lookupKey(path)
+	switch key {
+	case "city":
+		if rest == "" {
+			return n.City, nil
+		}
+		return nil, &FieldNotFoundError{Path: full}
+	}
+	return nil, &FieldNotFoundError{Path: full}
+}
+
+func (n *Items) GetPrice() float64 {
+	if n != nil {
+		return n.Price
+	}
+	return 0
+}
+
+func (n *Items) SetPrice(v float64) {
+	n.Price = v
+}
+
+func (n *Items) Lookup(path string) (any, bool) {
+	v, err := n.lookup(path, path)
+	return v, err == nil
+}
+
+func (n *Items) LookupPath(path string) (any, error) {
+	return n.lookup(path, path)
+}
+
+func (n *Items) lookup(full, path string) (any, error) {
+	if n == nil {
+		return nil, &FieldNotFoundError{Path: full}
+	}
+	if path == "" {
+		return n, nil
+	}
+	key, rest := lookupKey(path)
+	switch key {
+	case "price":
+		if rest == "" {
+			return n.Price, nil
+		}
+		return nil, &FieldNotFoundError{Path: full}
+	}
+	return nil, &FieldNotFoundError{Path: full}
+}
+
+// FieldNotFoundError Lookup的路径中属性不存在
+type FieldNotFoundError struct {
+	Path string
+}
+
+func (e *FieldNotFoundError) Error() string {
+	return "field not found: " + e.Path
+}
+
+// IndexOutOfRangeError Lookup的路径中数组下标越界
+type IndexOutOfRangeError struct {
+	Path  string
+	Index int
+	Len   int
+}
+
+func (e *IndexOutOfRangeError) Error() string {
+	return "index out of range [" + strconv.Itoa(e.Index) + "] with length " + strconv.Itoa(e.Len) + ": " + e.Path
+}
+
+// lookupKey 拆分路径，返回第一个属性名和剩余的路径
+func lookupKey(path string) (string, string) {
+	path = strings.TrimPrefix(path, ".")
+	i := strings.IndexAny(path, ".[")
+	if i == -1 {
+		return path, ""
+	}
+	return path[:i], path[i:]
+}
+
+// lookupIndex 解析路径开头的数组下标，例如[2]，返回下标和剩余的路径
+func lookupIndex(full, path string, length int) (int, string, error) {
+	if !strings.HasPrefix(path, "[") {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	end := strings.IndexByte(path, ']')
+	if end == -1 {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	i, err := strconv.Atoi(path[1:end])
+	if err != nil {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	// 负数转换为uint后一定越界
+	if uint(i) >= uint(length) {
+		return 0, "", &IndexOutOfRangeError{Path: full, Index: i, Len: length}
+	}
+	return i, path[end+1:], nil
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试嵌套结构生成Get方法",
+			args: args{
+				jsonStr: `{
+  "a": {
+    "b": 1
+  }
+}`,
+				config: &Config{
+					NestFlag:     true,
+					AccessorFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	A struct {
+		B int |json:"b"|
+	} |json:"a"|
+}
+
+func (n *AutoGenerated) GetA() *struct {
+	B int |json:"b"|
+} {
+	if n != nil {
+		return &n.A
+	}
+	return nil
+}
+
+func (n *AutoGenerated) Lookup(path string) (interface{}, bool) {
+	v, err := n.lookup(path, path)
+	return v, err == nil
+}
+
+func (n *AutoGenerated) LookupPath(path string) (interface{}, error) {
+	return n.lookup(path, path)
+}
+
+func (n *AutoGenerated) lookup(full, path string) (interface{}, error) {
+	if n == nil {
+		return nil, &FieldNotFoundError{Path: full}
+	}
+	if path == "" {
+		return n, nil
+	}
+	key, rest := lookupKey(path)
+	switch key {
+	case "a":
+		if rest == "" {
+			return n.A, nil
+		}
+		key, rest := lookupKey(rest)
+		switch key {
+		case "b":
+			if rest == "" {
+				return n.A.B, nil
+			}
+			return nil, &FieldNotFoundError{Path: full}
+		}
+	}
+	return nil, &FieldNotFoundError{Path: full}
+}
+
+// FieldNotFoundError Lookup的路径中属性不存在
+type FieldNotFoundError struct {
+	Path string
+}
+
+func (e *FieldNotFoundError) Error() string {
+	return "field not found: " + e.Path
+}
+
+// IndexOutOfRangeError Lookup的路径中数组下标越界
+type IndexOutOfRangeError struct {
+	Path  string
+	Index int
+	Len   int
+}
+
+func (e *IndexOutOfRangeError) Error() string {
+	return "index out of range [" + strconv.Itoa(e.Index) + "] with length " + strconv.Itoa(e.Len) + ": " + e.Path
+}
+
+// lookupKey 拆分路径，返回第一个属性名和剩余的路径
+func lookupKey(path string) (string, string) {
+	path = strings.TrimPrefix(path, ".")
+	i := strings.IndexAny(path, ".[")
+	if i == -1 {
+		return path, ""
+	}
+	return path[:i], path[i:]
+}
+
+// lookupIndex 解析路径开头的数组下标，例如[2]，返回下标和剩余的路径
+func lookupIndex(full, path string, length int) (int, string, error) {
+	if !strings.HasPrefix(path, "[") {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	end := strings.IndexByte(path, ']')
+	if end == -1 {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	i, err := strconv.Atoi(path[1:end])
+	if err != nil {
+		return 0, "", &FieldNotFoundError{Path: full}
+	}
+	// 负数转换为uint后一定越界
+	if uint(i) >= uint(length) {
+		return 0, "", &IndexOutOfRangeError{Path: full, Index: i, Len: length}
+	}
+	return i, path[end+1:], nil
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试生成示例变量",
+			args: args{
+				jsonStr: `[
+  {
+    "id": 1,
+    "name": "a\"b",
+    "location": {
+      "city": "枣庄"
+    },
+    "tags": ["a", "b"],
+    "items": [
+      {
+        "price": 1.5
+      }
+    ],
+    "extra": [1, "x"]
+  },
+  {
+    "id": 2,
+    "name": null,
+    "location": null
+  }
+]`,
+				config: &Config{
+					ExampleFlag: true,
+					PointerFlag: true,
+					Nullable:    Nullable1,
+				},
+			},
+			want: `type AutoGenerated struct {
+	ID       int           |json:"id"|
+	Name     *string       |json:"name"|
+	Location *Location     |json:"location"|
+	Tags     []string      |json:"tags"|
+	Items    []*Items      |json:"items"|
+	Extra    []interface{} |json:"extra"|
+}
+
+type Location struct {
+	City string |json:"city"|
+}
+
+type Items struct {
+	Price float64 |json:"price"|
+}
+
+var Example = []AutoGenerated{
+	{
+		ID: 1,
+		Name: func() *string {
+			var v string = "a\"b"
+			return &v
+		}(),
+		Location: &Location{
+			City: "枣庄",
+		},
+		Tags: []string{
+			"a",
+			"b",
+		},
+		Items: []*Items{
+			{
+				Price: 1.5,
+			},
+		},
+		Extra: []interface{}{
+			float64(1),
+			"x",
+		},
+	},
+	{
+		ID: 2,
+	},
+}`,
+			wantErr: false,
+		},
+		{
+			name: "web使用的测试case",
+			args: args{
+				jsonStr: `{
+  // 支持中文key
+  "地址": "",
+  "doc_url": "http://localhost", // golint命名优化
+  // 重名会在末尾进行递增编号
+  "docUrl": "http://localhost",
+  // 整形数字，小于等于int32设置为int，大于int32设置为int64
+  "int1": 1,
+  "int2": 3000000000,
+  // 浮点型数字，全部设置为float64
+  "float": 1.15,
+  // 如果是数组，会合并对象的属性和属性类型
+  "a": [
+    [{
+      // 这个a1对象属性不全，会合并数组内其他a1对象的属性
+      "a1": {
+        "a2": [{
+          "a3": "123" // 类型不同，判断为interface{}
+        }, {
+          "b3": ""
+        }]
+      }
+    }, {
+      "a1": {
+        "a2": [{
+          "a3": 123 // 类型不同，判断为interface{}
+        }, {
+          "c3": ""
+        }],
+        "b2": ""
+      },
+      "b1": {
+        "b2": ""
+      }
+    }]
+  ]
+}`,
+				config: &Config{
+					Comment: Comment1,
+				},
+			},
+			want: `type AutoGenerated struct {
+	// 支持中文key
+	Dz string |json:"地址"|
+	// golint命名优化
+	DocURL string |json:"doc_url"|
+	// 重名会在末尾进行递增编号
+	DocURL1 string |json:"docUrl"|
+	// 整形数字，小于等于int32设置为int，大于int32设置为int64
+	Int1 int   |json:"int1"|
+	Int2 int64 |json:"int2"|
+	// 浮点型数字，全部设置为float64
+	Float float64 |json:"float"|
+	// 如果是数组，会合并对象的属性和属性类型
+	A [][]A |json:"a"|
+}
+
+type A struct {
+	// 这个a1对象属性不全，会合并数组内其他a1对象的属性
+	A1 A1 |json:"a1"|
+	B1 B1 |json:"b1"|
+}
+
+type A1 struct {
+	A2 []A2   |json:"a2"|
+	B2 string |json:"b2"|
+}
+
+type A2 struct {
+	// 类型不同，判断为interface{}
+	A3 interface{} |json:"a3"|
+	B3 string      |json:"b3"|
+	C3 string      |json:"c3"|
+}
+
+type B1 struct {
+	B2 string |json:"b2"|
+}`,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want = strings.ReplaceAll(tt.want, "|", "`")
+			got, err := Generate(tt.args.jsonStr, tt.args.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Generate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Generate() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getJSONType(t *testing.T) {
+	type args struct {
+		value []byte
+		t     jsonparser.ValueType
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			args: args{
+				value: []byte("10.5"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			args: args{
+				value: []byte("10.0"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			args: args{
+				value: []byte("0.0"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			args: args{
+				value: []byte("100"),
+				t:     jsonparser.Number,
+			},
+			want: TypeInt,
+		},
+		{
+			name: "3000000000",
+			args: args{
+				value: []byte("3000000000"),
+				t:     jsonparser.Number,
+			},
+			want: TypeInt64,
+		},
+		{
+			name: "0",
+			args: args{
+				value: []byte("0"),
+				t:     jsonparser.Number,
+			},
+			want: TypeInt,
+		},
+		{
+			name: "-0",
+			args: args{
+				value: []byte("-0"),
+				t:     jsonparser.Number,
+			},
+			want: TypeInt,
+		},
+		{
+			name: "-1",
+			args: args{
+				value: []byte("-1"),
+				t:     jsonparser.Number,
+			},
+			want: TypeNegInt,
+		},
+		{
+			name: "2147483647",
+			args: args{
+				value: []byte("2147483647"),
+				t:     jsonparser.Number,
+			},
+			want: TypeInt,
+		},
+		{
+			name: "2147483648",
+			args: args{
+				value: []byte("2147483648"),
+				t:     jsonparser.Number,
+			},
+			want: TypeInt64,
+		},
+		{
+			name: "-2147483648",
+			args: args{
+				value: []byte("-2147483648"),
+				t:     jsonparser.Number,
+			},
+			want: TypeNegInt,
+		},
+		{
+			name: "-2147483649",
+			args: args{
+				value: []byte("-2147483649"),
+				t:     jsonparser.Number,
+			},
+			want: TypeNegInt64,
+		},
+		{
+			name: "9223372036854775807",
+			args: args{
+				value: []byte("9223372036854775807"),
+				t:     jsonparser.Number,
+			},
+			want: TypeInt64,
+		},
+		{
+			name: "9223372036854775808",
+			args: args{
+				value: []byte("9223372036854775808"),
+				t:     jsonparser.Number,
+			},
+			want: TypeUint64,
+		},
+		{
+			name: "18446744073709551615",
+			args: args{
+				value: []byte("18446744073709551615"),
+				t:     jsonparser.Number,
+			},
+			want: TypeUint64,
+		},
+		{
+			name: "18446744073709551616",
+			args: args{
+				value: []byte("18446744073709551616"),
+				t:     jsonparser.Number,
+			},
+			want: TypeOverflow,
+		},
+		{
+			name: "-9223372036854775808",
+			args: args{
+				value: []byte("-9223372036854775808"),
+				t:     jsonparser.Number,
+			},
+			want: TypeNegInt64,
+		},
+		{
+			name: "-9223372036854775809",
+			args: args{
+				value: []byte("-9223372036854775809"),
+				t:     jsonparser.Number,
+			},
+			want: TypeOverflow,
+		},
+		{
+			name: "123456789012345678901234567890",
+			args: args{
+				value: []byte("123456789012345678901234567890"),
+				t:     jsonparser.Number,
+			},
+			want: TypeOverflow,
+		},
+		{
+			name: "1e10",
+			args: args{
+				value: []byte("1e10"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			name: "1E5",
+			args: args{
+				value: []byte("1E5"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			name: "1e+5",
+			args: args{
+				value: []byte("1e+5"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			name: "-1.5e-3",
+			args: args{
+				value: []byte("-1.5e-3"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			name: "0.5",
+			args: args{
+				value: []byte("0.5"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			name: "-0.0",
+			args: args{
+				value: []byte("-0.0"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			name: "boolean true",
+			args: args{
+				value: []byte("true"),
+				t:     jsonparser.Boolean,
+			},
+			want: TypeBool,
+		},
+		{
+			name: "string abc",
+			args: args{
+				value: []byte("abc"),
+				t:     jsonparser.String,
+			},
+			want: TypeString,
+		},
+		{
+			name: "string 123",
+			args: args{
+				value: []byte("123"),
+				t:     jsonparser.String,
+			},
+			want: TypeQuotedInt,
+		},
+		{
+			name: "string -5",
+			args: args{
+				value: []byte("-5"),
+				t:     jsonparser.String,
+			},
+			want: TypeQuotedNegInt,
+		},
+		{
+			name: "string 3000000000",
+			args: args{
+				value: []byte("3000000000"),
+				t:     jsonparser.String,
+			},
+			want: TypeQuotedInt64,
+		},
+		{
+			name: "string 18446744073709551615",
+			args: args{
+				value: []byte("18446744073709551615"),
+				t:     jsonparser.String,
+			},
+			want: TypeQuotedUint64,
+		},
+		{
+			name: "string 1.5",
+			args: args{
+				value: []byte("1.5"),
+				t:     jsonparser.String,
+			},
+			want: TypeQuotedFloat64,
+		},
+		{
+			name: "string 1e3",
+			args: args{
+				value: []byte("1e3"),
+				t:     jsonparser.String,
+			},
+			want: TypeQuotedFloat64,
+		},
+		{
+			name: "string true",
+			args: args{
+				value: []byte("true"),
+				t:     jsonparser.String,
+			},
+			want: TypeQuotedBool,
+		},
+		{
+			name: "string 007",
+			args: args{
+				value: []byte("007"),
+				t:     jsonparser.String,
+			},
+			want: TypeString,
+		},
+		{
+			name: "string ",
+			args: args{
+				value: []byte(""),
+				t:     jsonparser.String,
+			},
+			want: TypeString,
+		},
+		{
+			name: "null null",
+			args: args{
+				value: []byte("null"),
+				t:     jsonparser.Null,
+			},
+			want: TypeNil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getJSONType(tt.args.value, tt.args.t); got != tt.want {
+				t.Errorf("getJSONType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	// 不合法的数字在解析时返回错误，不会用来判断类型
+	for _, json := range []string{`{"a":0x10}`, `{"a":01}`, `{"a":Infinity}`, `{"a":-Infinity}`, `{"a":NaN}`, `{"a":+1}`} {
+		if got, err := Generate(json, &Config{}); err == nil {
+			t.Errorf("Generate(%s) = %v, want error", json, got)
+		}
+	}
+}
+
+func Test_mergeFiledType(t *testing.T) {
+	tests := []struct {
+		name  string
+		array []string
+		want  string
+	}{
+		{name: "int", array: []string{TypeInt, TypeInt}, want: TypeInt},
+		{name: "负数", array: []string{TypeInt, TypeNegInt}, want: TypeNegInt},
+		{name: "int64", array: []string{TypeInt, TypeInt64}, want: TypeInt64},
+		{name: "负数int64", array: []string{TypeNegInt, TypeInt64}, want: TypeNegInt64},
+		{name: "uint64", array: []string{TypeInt, TypeInt64, TypeUint64}, want: TypeUint64},
+		{name: "uint64和负数", array: []string{TypeNegInt, TypeUint64}, want: TypeOverflow},
+		{name: "overflow", array: []string{TypeInt, TypeOverflow}, want: TypeOverflow},
+		{name: "float64", array: []string{TypeOverflow, TypeFloat64, TypeInt}, want: TypeFloat64},
+		{name: "数字和null", array: []string{TypeNil, TypeInt64}, want: TypeInt64},
+		{name: "数字和字符串", array: []string{TypeString, TypeInt}, want: TypeAny},
+		{name: "字符串形式的数字", array: []string{TypeQuotedInt, TypeQuotedInt64}, want: TypeQuotedInt64},
+		{name: "字符串形式的负数", array: []string{TypeQuotedNegInt, TypeQuotedInt64}, want: TypeQuotedNegInt64},
+		{name: "字符串形式的uint64和负数", array: []string{TypeQuotedNegInt, TypeQuotedUint64}, want: TypeQuotedOverflow},
+		{name: "字符串形式的数字和字符串", array: []string{TypeQuotedInt, TypeString}, want: TypeString},
+		{name: "字符串形式的数字和布尔值", array: []string{TypeQuotedInt, TypeQuotedBool}, want: TypeString},
+		{name: "字符串形式的数字和数字", array: []string{TypeQuotedInt, TypeInt}, want: TypeAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeFiledType(tt.array, false); got != tt.want {
+				t.Errorf("mergeFiledType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_goMinorVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    int
+	}{
+		{version: "", want: -1},
+		{version: "1.18", want: 18},
+		{version: "go1.21.3", want: 21},
+		{version: " 1.9 ", want: 9},
+		{version: "2.0", want: -1},
+		{version: "latest", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if got := goMinorVersion(&Config{GoVersion: tt.version}); got != tt.want {
+				t.Errorf("goMinorVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateCheck(t *testing.T) {
+	json := `{"id":12345678901234567890,"name":null,"price":"1.5","tags":["a"],"items":[{"id":1,"note":null},{"id":2.5}],"data":{"ok":true,"v":null}}`
+	configs := []Config{
+		{AccessorFlag: true, SetterFlag: true, ExampleFlag: true},
+		{Nullable: Nullable1, Overflow: Overflow1, Quoted: Quoted1, AccessorFlag: true},
+		{Nullable: Nullable2, Overflow: Overflow2, ExampleFlag: true, GoVersion: "1.17"},
+		{Nullable: Nullable3, NumberPolicy: Number3, AccessorFlag: true, SetterFlag: true, ExampleFlag: true, GoVersion: "1.18"},
+		{NestFlag: true, Nullable: Nullable3, AccessorFlag: true, ExampleFlag: true, GoVersion: "1.18"},
+	}
+	for i, config := range configs {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			config.CheckFlag = true
+			if got, err := Generate(json, &config); err != nil {
+				t.Errorf("Generate() error = %v\n%v", err, got)
+			}
+		})
+	}
+}
+
+func TestGenerateEnum(t *testing.T) {
+	json := `{"status":"x","items":[{"status":"active","level":1,"note":null},{"status":"deleted","level":null},{"status":"active","level":2},{"status":"deleted","level":1,"note":"n"}]}`
+	configs := []Config{
+		{EnumMethodFlag: true, EnumStrictFlag: true, AccessorFlag: true, ExampleFlag: true},
+		{Nullable: Nullable1, EnumMethodFlag: true, AccessorFlag: true, SetterFlag: true, ExampleFlag: true},
+		{Nullable: Nullable2, EnumStrictFlag: true, ExampleFlag: true},
+		{Nullable: Nullable3, EnumStrictFlag: true, AccessorFlag: true, ExampleFlag: true, GoVersion: "1.18"},
+		{NestFlag: true, Nullable: Nullable3, EnumMethodFlag: true, EnumStrictFlag: true, ExampleFlag: true, GoVersion: "1.18"},
+	}
+	for i, config := range configs {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			config.EnumFlag = true
+			config.CheckFlag = true
+			got, err := Generate(json, &config)
+			if err != nil {
+				t.Fatalf("Generate() error = %v\n%v", err, got)
+			}
+			if !strings.Contains(got, "ItemsStatusActive") || strings.Contains(got, "type AutoGeneratedStatus") {
+				t.Errorf("Generate() got = %s", got)
+			}
+			// 严格模式拒绝未知的值
+			if config.EnumStrictFlag && !strings.Contains(got, `fmt.Errorf("invalid ItemsStatus: %s", data)`) {
+				t.Errorf("Generate() got = %s", got)
+			}
+		})
+	}
+	// 不同的值太多或者重复的次数不够时不是枚举
+	for _, config := range []Config{{EnumFlag: true, EnumMaxValues: 1}, {EnumFlag: true, EnumMinRepeat: 3}} {
+		if got, _ := Generate(json, &config); strings.Contains(got, "ItemsStatus") {
+			t.Errorf("Generate() got = %s", got)
+		}
+	}
+}
+
+func Test_checkSource(t *testing.T) {
+	src := "type Root struct {\n\tData Data `json:\"data\"`\n}\ntype Data struct {\n\tItems []struct {\n\t\tID Unknown `json:\"id\"`\n\t} `json:\"items\"`\n\tName string `json:\"name\"`\n\tName string `json:\"name2\"`\n}\n"
+	got := checkSource([]byte(src), map[string]string{"Root": "$", "Data": "$.data"})
+	want := []Diagnostic{
+		{Path: "$.data.items[].id", Line: 6, Column: 6, Message: "undefined: Unknown"},
+		{Path: "$.data.name2", Line: 9, Column: 2, Message: "Name redeclared"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("checkSource() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Path != want[i].Path || got[i].Line != want[i].Line || got[i].Column != want[i].Column ||
+			!strings.HasPrefix(got[i].Message, want[i].Message) {
+			t.Errorf("checkSource()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func Test_singularKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "items", want: "item"},
+		{key: "categories", want: "category"},
+		{key: "addresses", want: "address"},
+		{key: "boxes", want: "box"},
+		{key: "matches", want: "match"},
+		{key: "heroes", want: "hero"},
+		{key: "people", want: "person"},
+		{key: "children", want: "child"},
+		{key: "leaves", want: "leaf"},
+		{key: "statuses", want: "status"},
+		{key: "status", want: "status"},
+		{key: "class", want: "class"},
+		{key: "analysis", want: "analysis"},
+		{key: "data", want: "data"},
+		{key: "news", want: "news"},
+		{key: "s", want: "s"},
+		{key: "Items", want: "Item"},
+		{key: "ITEMS", want: "ITEM"},
+		{key: "order_items", want: "order_item"},
+		{key: "orderItems", want: "order_item"},
+		{key: "items2", want: "items2"},
+		{key: "商品", want: "商品"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := singularKey(tt.key, &Config{}); got != tt.want {
+				t.Errorf("singularKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	// 自定义的规则优先于内置的规则
+	config := &Config{
+		SingularRules: []SingularRule{{Suffix: "ves", Replacement: "ve"}},
+		Irregulars:    map[string]string{"cacti": "cactus", "data": "datum"},
+	}
+	for key, want := range map[string]string{"Cacti": "Cactus", "data": "datum", "curves": "curve", "leaves": "leaf", "items": "item"} {
+		if got := singularKey(key, config); got != want {
+			t.Errorf("singularKey(%v) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func Test_renderComment(t *testing.T) {
+	config := &Config{CommentStyle: CommentStyle1, CommentNameFlag: true, CommentWidth: 20}
+	tests := []struct {
+		name    string
+		comment string
+		lineEnd bool
+		want    string
+	}{
+		{name: "单行注释", comment: "// 用户编号", want: "// ID 用户编号"},
+		{name: "已经以名称开头", comment: "//ID of user", want: "// ID of user"},
+		{name: "块注释", comment: "/**\n * 用户编号\n *\n * 自增\n */", want: "// ID 用户编号\n//\n// 自增"},
+		{name: "装饰字符", comment: "// =====\n// # 编号 #\n// -----", want: "// ID 编号 #"},
+		{name: "按照宽度换行", comment: "// the unique id of the user", want: "// ID the unique id of\n// the user"},
+		{name: "中文按照宽度换行", comment: "// 用户的唯一编号，注册时生成", want: "// ID 用户的唯一编号，\n// 注册时生成"},
+		{name: "行尾注释合并为一行", comment: "/* 用户\n编号 */", lineEnd: true, want: "// 用户 编号"},
+		{name: "只有装饰字符", comment: "/* *** */", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := renderComment(tt.comment, "ID", tt.lineEnd, config); got != tt.want {
+				t.Errorf("renderComment() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_formatName(t *testing.T) {
+	config := &Config{
+		Initialisms:    []string{"SKU", "OAuth", "VIP", "iOS"},
+		WordDictionary: []string{"user", "name", "order", "status"},
+	}
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "userid", want: "UserID"},
+		{key: "username", want: "UserName"},
+		{key: "orderuserid", want: "OrderUserID"},
+		{key: "abcuser", want: "Abcuser"},
+		{key: "skuId", want: "SKUID"},
+		{key: "vipstatus", want: "VIPStatus"},
+		{key: "oauth_token", want: "OAuthToken"},
+		{key: "OAuthToken", want: "OAuthToken"},
+		{key: "iOSVersion", want: "IOSVersion"},
+		{key: "user_2", want: "User2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := formatName(tt.key, config); got != tt.want {
+				t.Errorf("formatName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_transliterate(t *testing.T) {
+	config := &Config{Pinyin: Pinyin1, TransliterateFlag: true}
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "用户名", want: "YongHuMing"},
+		{key: "订单id", want: "DingDanID"},
+		{key: "长度", want: "ChangDu"},
+		{key: "ラーメン", want: "Ramen"},
+		{key: "きっぷ", want: "Kippu"},
+		{key: "しゃしん", want: "Shashin"},
+		{key: "이름", want: "Ireum"},
+		{key: "ПолноеИмя", want: "PolnoeImya"},
+		{key: "café", want: "Cafe"},
+		{key: "Größe", want: "Grosse"},
+		{key: "!!!", want: FallbackName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := formatName(tt.key, config); got != tt.want {
+				t.Errorf("formatName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	// 默认只使用拼音首字母，其他文字转换后为空时使用默认名称
+	if got := formatName("用户名", &Config{}); got != "Yhm" {
+		t.Errorf("formatName() = %v, want %v", got, "Yhm")
+	}
+	if got := formatName("이름", &Config{}); got != FallbackName {
+		t.Errorf("formatName() = %v, want %v", got, FallbackName)
+	}
+}
+
+func TestGenerateReserved(t *testing.T) {
+	// go的关键字和预声明的标识符作为属性名和结构体名称，生成的代码可以通过类型检查
+	var names []string
+	for i := token.BREAK; i <= token.VAR; i++ {
+		names = append(names, i.String())
+	}
+	names = append(names, types.Universe.Names()...)
+	var fields []string
+	for _, name := range names {
+		fields = append(fields, fmt.Sprintf("%q:{%q:1}", name, name))
+	}
+	json := "{" + strings.Join(fields, ",") + "}"
+	for _, config := range []Config{{}, {NestFlag: true}, {AccessorFlag: true, ExampleFlag: true}} {
+		config.CheckFlag = true
+		if got, err := Generate(json, &config); err != nil {
+			t.Errorf("Generate() error = %v\n%v", err, got)
+		}
+	}
+	// 结构体名称和生成的代码中的名称重复
+	json = `{"optional":{"a":1},"example":{"b":null},"fieldNotFoundError":{"c":1},"nullString":{"d":"x"},"items":[{"e":null},{"e":1}]}`
+	tests := []struct {
+		name   string
+		config *Config
+		want   []string
+	}{
+		{
+			name:   "默认",
+			config: &Config{},
+			want:   []string{"type Optional struct", "type Example struct", "type FieldNotFoundError struct", "type NullString struct"},
+		},
+		{
+			name:   "末尾加下划线",
+			config: &Config{Nullable: Nullable3, GoVersion: "1.18", AccessorFlag: true, ExampleFlag: true},
+			want:   []string{"type Optional_ struct", "type Example_ struct", "type FieldNotFoundError_ struct", "type NullString struct"},
+		},
+		{
+			name:   "加上前缀",
+			config: &Config{Nullable: Nullable2, Reserved: Reserved1, ReservedPrefix: "My"},
+			want:   []string{"type Optional struct", "type MyNullString struct"},
+		},
+		{
+			name:   "自定义名称",
+			config: &Config{Nullable: Nullable3, GoVersion: "1.18", Reserved: Reserved2, ReservedNames: map[string]string{"Optional": "OptionalValue"}, ExampleFlag: true},
+			want:   []string{"type OptionalValue struct", "type Example_ struct"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.CheckFlag = true
+			got, err := Generate(json, tt.config)
+			if err != nil {
+				t.Fatalf("Generate() error = %v\n%v", err, got)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("Generate() got = %s, want %s", got, want)
+				}
+			}
+		})
+	}
+}
+
+// 生成多层嵌套的json，每层包含数组和对象，大小为几MB
+func TestGenerateReader(t *testing.T) {
+	// 流式读取的结果和一次读取相同
+	records := []string{
+		`{"id":1, // 编号
+			"name":"a","tags":["x"],"items":[{"id":1,"price":null}],"data":{"ok":true}}`,
+		`{"id":-2,"name":null,"tags":[],"items":[{"id":2.5,"price":"1"}],"data":{"ok":false,"v":[[1]]}}`,
+		`{"id":3,"extra":{"a":[{"b":1},{"b":"2"}]},"items":[],"data":null}`,
+		`{
+			// 多行的记录
+			"id": 4, "name": "d", "extra": {"a": [{"c": true}]}
+			// 结束
+		}`,
+	}
+	json := "[" + strings.Join(records, ",\n") + "]"
+	configs := []Config{
+		{},
+		{NestFlag: true, Comment: Comment2},
+		{Nullable: Nullable2, Comment: Comment1, ExampleFlag: true},
+		{Comment: Comment1, CommentPositionFlag: true},
+		{NestFlag: true, Comment: Comment2, CommentPositionFlag: true},
+	}
+	for i, config := range configs {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			c := config
+			want, err := Generate(json, &c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 最外层的数组不能覆盖对象结束括号前的注释
+			if config.CommentPositionFlag && !strings.Contains(want, "// 结束") {
+				t.Fatalf("Generate() got = %v, want end comment", want)
+			}
+			if config.ExampleFlag {
+				// 流式读取时示例只使用第一条记录
+				want = want[:strings.Index(want, "var Example")]
+			}
+			for _, input := range []string{json, strings.Join(records, "\n"), records[0] + records[1] + "[" + records[2] + "]" + records[3]} {
+				c = config
+				got, err := GenerateReader(strings.NewReader(input), &c)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if config.ExampleFlag {
+					got = got[:strings.Index(got, "var Example")]
+				}
+				if got != want {
+					t.Errorf("GenerateReader() = %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateReaderSample(t *testing.T) {
+	ndjson := "{\"a\":1}\n{\"b\":1}\n{\"c\":1}\n{\"d\":1}\n{\"e\":1}\n"
+	tests := []struct {
+		name   string
+		config Config
+		want   []string
+	}{
+		{name: "first", config: Config{Sample: Sample1, SampleSize: 2}, want: []string{"A", "B"}},
+		{name: "every", config: Config{Sample: Sample3, SampleSize: 2}, want: []string{"A", "C", "E"}},
+		{name: "reservoir", config: Config{Sample: Sample2, SampleSize: 5}, want: []string{"A", "B", "C", "D", "E"}},
+		{name: "all", config: Config{Sample: Sample1}, want: []string{"A", "B", "C", "D", "E"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateReader(strings.NewReader(ndjson), &tt.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var fields []string
+			for _, line := range strings.Split(got, "\n") {
+				if f := strings.Fields(line); len(f) == 3 {
+					fields = append(fields, f[0])
+				}
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("GenerateReader() fields = %v, want %v", fields, tt.want)
+			}
+		})
+	}
+	// 蓄水池采样的数量固定，相同的种子结果相同
+	config := Config{Sample: Sample2, SampleSize: 2, SampleSeed: 1}
+	got, err := GenerateReader(strings.NewReader(ndjson), &config)
+	if err != nil || strings.Count(got, "`json:") != 2 {
+		t.Errorf("GenerateReader() = %v, %v", got, err)
+	}
+	if again, _ := GenerateReader(strings.NewReader(ndjson), &config); again != got {
+		t.Errorf("GenerateReader() = %v, want %v", again, got)
+	}
+	if _, err = GenerateReader(strings.NewReader(" [1, \"a\"] // 注释"), &Config{}); err != EmptyStreamError {
+		t.Errorf("GenerateReader() error = %v, want %v", err, EmptyStreamError)
+	}
+	if _, err = GenerateReader(strings.NewReader(`[{"a":1},{"b":`), &Config{}); err == nil {
+		t.Errorf("GenerateReader() want error")
+	}
+}
+
+func TestGenerateParallel(t *testing.T) {
+	// 属性在不同的位置第一次出现，并且类型在后面的元素中变化，用来检查合并后的顺序和类型
+	var buff strings.Builder
+	// 数组作为属性，元素的结束括号前的注释属于数组对应的结构体
+	buff.WriteString(`{"list": [`)
+	for i := 0; i < 1000; i++ {
+		if i > 0 {
+			buff.WriteString(",")
+		}
+		fmt.Fprintf(&buff, `{"k%d": %d, "id": %d, // 注释%d
+			"items": [`, i%37, i, -i, i%5)
+		for j := 0; j < 150; j++ {
+			if j > 0 {
+				buff.WriteString(",")
+			}
+			fmt.Fprintf(&buff, `{"v%d": %d}`, (i+j)%11, j)
+		}
+		fmt.Fprintf(&buff, `], "data": {"k%d": null, "s": "%d"}`, i%13, i)
+		// 结束括号前的注释只在后面的元素中出现
+		if i > 500 && i%7 == 3 {
+			fmt.Fprintf(&buff, "\n// 结束%d\n", i)
+		}
+		buff.WriteString("}")
+	}
+	buff.WriteString("]}")
+	json := buff.String()
+	configs := []Config{
+		{},
+		{NestFlag: true, Comment: Comment2, Nullable: Nullable1},
+		{Comment: Comment1, Quoted: Quoted1},
+		{Comment: Comment1, CommentPositionFlag: true},
+		{NestFlag: true, Comment: Comment2, CommentPositionFlag: true},
+	}
+	for i, config := range configs {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			c := config
+			want, err := Generate(json, &c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if config.CommentPositionFlag && !strings.Contains(want, "// 结束") {
+				t.Fatalf("Generate() got = %v, want end comment", want)
+			}
+			for _, parallel := range []int{2, 3, 8, 64} {
+				c = config
+				c.Parallel = parallel
+				got, err := Generate(json, &c)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != want {
+					t.Errorf("Generate() Parallel = %d, got %v, want %v", parallel, got, want)
+				}
+			}
+			// 多个协程共用同一个Config，每次调用使用自己的协程池
+			c = config
+			c.Parallel = 4
+			var wg sync.WaitGroup
+			for j := 0; j < 4; j++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if got, err := Generate(json, &c); err != nil || got != want {
+						t.Errorf("Generate() concurrent got %v, error %v", got, err)
+					}
+				}()
+			}
+			wg.Wait()
+		})
+	}
+}
+
+func TestGenerateLimit(t *testing.T) {
+	deep := `{"a":` + strings.Repeat("[", 100000) + strings.Repeat("]", 100000) + "}"
+	json := `{"a":{"b":1,"c":[{"d":2}]},"e":[[1]]}`
+	array := "[" + strings.Repeat(`{"a":{"b":1},"c":[{"b":1}]},`, 500) + `{"a":{"b":"x"}}]`
+	tests := []struct {
+		name   string
+		json   string
+		config Config
+		limit  string
+	}{
+		{name: "deep", json: deep, config: Config{MaxDepth: DefaultMaxDepth}, limit: LimitDepth},
+		{name: "deep map", json: deep, config: Config{MaxDepth: DefaultMaxDepth, StructType: "map"}, limit: LimitDepth},
+		// 没有配置时使用默认的层数，避免栈溢出
+		{name: "deep default", json: deep, config: Config{}, limit: LimitDepth},
+		{name: "deep default map", json: deep, config: Config{StructType: "map"}, limit: LimitDepth},
+		{name: "depth", json: json, config: Config{MaxDepth: 3}, limit: LimitDepth},
+		{name: "bytes", json: json, config: Config{MaxBytes: len(json) - 1}, limit: LimitBytes},
+		{name: "fields", json: json, config: Config{MaxFields: 4}, limit: LimitFields},
+		{name: "types", json: json, config: Config{MaxTypes: 2}, limit: LimitTypes},
+		{name: "ok", json: json, config: Config{MaxDepth: 4, MaxBytes: len(json), MaxFields: 5, MaxTypes: 3}},
+		// 数组内的对象合并后的属性数量
+		{name: "array fields", json: array, config: Config{MaxFields: 3}, limit: LimitFields},
+		{name: "array fields parallel", json: array, config: Config{MaxFields: 3, Parallel: 4}, limit: LimitFields},
+		{name: "array ok", json: array, config: Config{MaxFields: 4, MaxTypes: 3}},
+		{name: "array ok parallel", json: array, config: Config{MaxFields: 4, MaxTypes: 3, Parallel: 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, reader := range []bool{false, true} {
+				config := tt.config
+				var err error
+				if reader {
+					_, err = GenerateReader(strings.NewReader(tt.json), &config)
+				} else {
+					_, err = Generate(tt.json, &config)
+				}
+				var limitErr *LimitError
+				if !errors.As(err, &limitErr) {
+					if tt.limit != "" || err != nil {
+						t.Errorf("Generate() reader = %v, error = %v, want %v", reader, err, tt.limit)
+					}
+					continue
+				}
+				if limitErr.Limit != tt.limit {
+					t.Errorf("Generate() reader = %v, limit = %v, want %v", reader, limitErr.Limit, tt.limit)
+				}
+			}
+		})
+	}
+}
+
+func FuzzGenerate(f *testing.F) {
+	f.Add(`{"a":1,"b":[{"c":"x"},null],"d":{"e":[[1.5]]}}`, uint8(0))
+	f.Add(`[{"a":1}, // 注释
+	{"a":"2","b":null}]`, uint8(1))
+	f.Add(`{"type":{},"list":[[],[{}]],"Example":[1,"a"]}`, uint8(2))
+	f.Add(`{"a":[{"b":1},2],"c":[[1],null]}`, uint8(3))
+	f.Add(`{"a":1,`, uint8(0))
+	f.Add("{\"`\":0,\"x\":[{\"a\\\\nb\":1,\"c\":2,\"c\":3},2]}", uint8(2))
+	f.Add(`{"a":{},"b":[{}],"c":[{"d":{}},null]}`, uint8(1))
+	f.Add(`{"a":[0("0"]}`, uint8(2))
+	f.Add(`[{"s":"a","n":1},{"s":"b","n":-1},{"s":"","n":1},{"s":"a-b","n":null}]`, uint8(3))
+	configs := []Config{
+		{},
+		{NestFlag: true, Comment: Comment2, Nullable: Nullable1},
+		{AccessorFlag: true, SetterFlag: true, ExampleFlag: true, Nullable: Nullable3, Quoted: Quoted1, EnumFlag: true, EnumStrictFlag: true, GoVersion: "1.18"},
+		{NestFlag: true, ExampleFlag: true, AccessorFlag: true, NameStrategy: Name2, SingularFlag: true, EnumFlag: true, EnumMethodFlag: true, EnumMinRepeat: 1},
+	}
+	f.Fuzz(func(t *testing.T, json string, index uint8) {
+		config := configs[int(index)%len(configs)]
+		config.MaxDepth = 64
+		config.CheckFlag = true
+		got, err := Generate(json, &config)
+		reader := config
+		_, _ = GenerateReader(strings.NewReader(json), &reader)
+		// 合法的json对象必须生成能通过编译检查的代码
+		var value interface{}
+		if err == nil || stdjson.Unmarshal([]byte(json), &value) != nil {
+			return
+		}
+		if _, ok := value.(map[string]interface{}); ok {
+			t.Errorf("Generate(%q) error = %v\n%v", json, err, got)
+		}
+	})
+}
+
+func benchmarkJSON(depth, width int) string {
+	var buff strings.Builder
+	var write func(level int)
+	write = func(level int) {
+		buff.WriteString("{")
+		for i := 0; i < width; i++ {
+			buff.WriteString(`"id": 12345, "name": "benchmark", "price": 1.5, "tags": ["a", "b", "c"], // 注释` + "\n")
+		}
+		buff.WriteString(`"items": [`)
+		for i := 0; i < width; i++ {
+			if i > 0 {
+				buff.WriteString(",")
+			}
+			buff.WriteString(`{"id": 1, "ok": true, "values": [[1, 2], [3, 4]]}`)
+		}
+		buff.WriteString("]")
+		if level < depth {
+			buff.WriteString(`, "child": `)
+			write(level + 1)
+		}
+		buff.WriteString("}")
+	}
+	write(1)
+	return buff.String()
+}
+
+func BenchmarkGenerateDeep(b *testing.B) {
+	json := benchmarkJSON(2000, 10)
+	b.SetBytes(int64(len(json)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Generate(json, &Config{MaxDepth: -1}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGenerateWide(b *testing.B) {
+	json := benchmarkJSON(5, 20000)
+	b.SetBytes(int64(len(json)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Generate(json, &Config{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGenerateParallel(b *testing.B) {
+	json := benchmarkJSON(5, 20000)
+	b.SetBytes(int64(len(json)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Generate(json, &Config{Parallel: runtime.NumCPU()}); err != nil {
+			b.Fatal(err)
+		}
 	}
 }
@@ -0,0 +1,299 @@
+package jsonparser
+
+import (
+	"bytes"
+)
+
+// 词法单元的类型，括号和分隔符使用字符本身
+const (
+	tokenString  = 's'
+	tokenLiteral = 'v'
+	tokenComment = '/'
+)
+
+// 依次处理词法单元，newline表示和前一个词法单元之间是否有换行，用来区分行尾注释
+// 只检查括号是否匹配，语法由checkValues检查
+func eachToken(data []byte, fn func(kind byte, token []byte, newline bool)) error {
+	newline := false
+	var stack []byte
+	for i := 0; i < len(data); {
+		c := data[i]
+		switch c {
+		case ' ', '\t', '\r':
+			i++
+			continue
+		case '\n':
+			newline = true
+			i++
+			continue
+		}
+		start := i
+		kind := c
+		switch c {
+		case '{', '[':
+			stack = append(stack, c)
+			i++
+		case '}', ']':
+			// '{'和'}'、'['和']'都相差2
+			if len(stack) == 0 || stack[len(stack)-1] != c-2 {
+				return MalformedJsonError
+			}
+			stack = stack[:len(stack)-1]
+			i++
+		case ':', ',':
+			i++
+		case '"':
+			end, _ := stringEnd(data[i+1:])
+			if end == -1 {
+				return MalformedStringError
+			}
+			i += end + 1
+			kind = tokenString
+		case '/':
+			if i+1 < len(data) && data[i+1] == '/' {
+				// 单行注释可能在输入的最后，没有换行
+				if end := bytes.IndexByte(data[i:], '\n'); end == -1 {
+					i = len(data)
+				} else {
+					i += end
+				}
+			} else if i+1 < len(data) && data[i+1] == '*' {
+				end := bytes.Index(data[i+2:], []byte("*/"))
+				if end == -1 {
+					return MalformedJsonError
+				}
+				i += end + 4
+			} else {
+				return MalformedJsonError
+			}
+		default:
+			// 数字、true、false、null
+			for i < len(data) && !isTokenDelimiter(data[i]) {
+				i++
+			}
+			kind = tokenLiteral
+		}
+		token := data[start:i]
+		if kind == tokenComment {
+			token = bytes.TrimRight(token, "\r")
+		}
+		fn(kind, token, newline)
+		newline = false
+	}
+	if len(stack) > 0 {
+		return MalformedJsonError
+	}
+	return nil
+}
+
+// 检查所有最外层的值，换行分隔的多个json(NDJSON)依次检查，值之间可以有注释
+// 嵌套层数超过DefaultMaxDepth时返回MaxDepthError
+func checkValues(data []byte) error {
+	p := &parser{maxDepth: DefaultMaxDepth}
+	offset := 0
+	count := 0
+	for {
+		off := nextToken(data[offset:])
+		if off == -1 {
+			break
+		}
+		offset += off
+		if data[offset] == '/' {
+			end := commentEnd(data[offset:])
+			if end == -1 {
+				// 最后的单行注释没有换行
+				if offset+1 < len(data) && data[offset+1] == '/' {
+					break
+				}
+				return MalformedJsonError
+			}
+			offset += end
+			continue
+		}
+		_, end, err := p.parseValue(data, offset)
+		if err != nil {
+			return err
+		}
+		offset = end
+		count++
+	}
+	if count == 0 {
+		return MalformedJsonError
+	}
+	return nil
+}
+
+func isTokenDelimiter(c byte) bool {
+	switch c {
+	case ' ', '\t', '\r', '\n', ',', ':', '[', ']', '{', '}', '/', '"':
+		return true
+	}
+	return false
+}
+
+// Format 格式化json，保留注释，indent是每一层的缩进
+// 和前一个值在同一行的注释保留在行尾，其他注释单独一行；换行分隔的多个json(NDJSON)分别格式化
+// 嵌套层数超过DefaultMaxDepth时返回MaxDepthError
+func Format(data []byte, indent string) ([]byte, error) {
+	if err := checkValues(data); err != nil {
+		return nil, err
+	}
+	var buff bytes.Buffer
+	depth := 0
+	// 写入下一个词法单元之前需要换行或者空格
+	pending, space := false, false
+	// 最后写入的是单行注释，后面的内容必须换行
+	lineComment := false
+	// 刚写入左括号，用来把空的对象和数组写成{}、[]
+	open := false
+	newline := func() {
+		buff.WriteByte('\n')
+		for i := 0; i < depth; i++ {
+			buff.WriteString(indent)
+		}
+	}
+	// 写入值或者左括号之前的换行和空格
+	prefix := func() {
+		if pending && buff.Len() > 0 {
+			newline()
+		} else if space {
+			buff.WriteByte(' ')
+		}
+		pending, space = false, false
+	}
+	err := eachToken(data, func(kind byte, token []byte, nl bool) {
+		if kind == tokenComment {
+			if buff.Len() > 0 && !nl {
+				// 行尾注释
+				buff.WriteByte(' ')
+				space = true
+			} else {
+				if buff.Len() > 0 {
+					newline()
+				}
+				pending = true
+			}
+			buff.Write(token)
+			lineComment = token[1] == '/'
+			if lineComment {
+				pending, space = true, false
+			}
+			open = false
+			return
+		}
+		switch kind {
+		case '{', '[':
+			prefix()
+			buff.Write(token)
+			depth++
+			pending, open = true, true
+		case '}', ']':
+			depth--
+			if !open {
+				newline()
+			}
+			buff.Write(token)
+			pending, space, open = false, false, false
+		case ',':
+			if lineComment {
+				newline()
+			}
+			buff.Write(token)
+			pending, space = true, false
+		case ':':
+			if lineComment {
+				newline()
+			}
+			buff.Write(token)
+			pending, space = false, true
+		default:
+			prefix()
+			buff.Write(token)
+			open = false
+		}
+		lineComment = false
+		// 最外层的值结束，下一个值另起一行
+		if depth == 0 && kind != ',' && kind != ':' {
+			pending = true
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// Minify 压缩json，去掉空白，stripComments为true时同时去掉注释
+// 保留的单行注释后面需要换行；换行分隔的多个json(NDJSON)之间保留换行
+// 嵌套层数超过DefaultMaxDepth时返回MaxDepthError
+func Minify(data []byte, stripComments bool) ([]byte, error) {
+	if err := checkValues(data); err != nil {
+		return nil, err
+	}
+	var buff bytes.Buffer
+	depth := 0
+	// 最外层的值已经结束
+	end := false
+	err := eachToken(data, func(kind byte, token []byte, nl bool) {
+		if kind == tokenComment {
+			if stripComments {
+				return
+			}
+			buff.Write(token)
+			if token[1] == '/' {
+				buff.WriteByte('\n')
+			}
+			return
+		}
+		// 最外层的下一个值另起一行
+		if depth == 0 && end && buff.Bytes()[buff.Len()-1] != '\n' {
+			buff.WriteByte('\n')
+		}
+		switch kind {
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+		}
+		buff.Write(token)
+		end = depth == 0
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// EscapeString 把json文档转义为字符串字面量的内容，不包含两边的引号
+func EscapeString(data []byte) []byte {
+	const hex = "0123456789abcdef"
+	buff := make([]byte, 0, len(data)+len(data)/8)
+	for _, c := range data {
+		switch c {
+		case '"', '\\':
+			buff = append(buff, '\\', c)
+		case '\n':
+			buff = append(buff, '\\', 'n')
+		case '\r':
+			buff = append(buff, '\\', 'r')
+		case '\t':
+			buff = append(buff, '\\', 't')
+		default:
+			if c < 0x20 {
+				buff = append(buff, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			} else {
+				buff = append(buff, c)
+			}
+		}
+	}
+	return buff
+}
+
+// UnescapeString 把字符串字面量还原为json文档，两边的引号可以省略
+func UnescapeString(data []byte) ([]byte, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return Unescape(data, nil)
+}
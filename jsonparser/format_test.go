@@ -0,0 +1,184 @@
+package jsonparser
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "基础对象",
+			data: `{"a":1,"b":[1,"x",{}],"c":{"d":[],"e":null}}`,
+			want: `{
+  "a": 1,
+  "b": [
+    1,
+    "x",
+    {}
+  ],
+  "c": {
+    "d": [],
+    "e": null
+  }
+}`,
+		},
+		{
+			name: "保留注释",
+			data: `// 根注释
+{"a":1, // a的注释
+ "b": /* b的注释 */ "x",
+   // c的注释
+ "c": [ // 数组的注释
+ 1] /* 块注释 */
+ // 结束的注释
+}`,
+			want: `// 根注释
+{
+  "a": 1, // a的注释
+  "b": /* b的注释 */ "x",
+  // c的注释
+  "c": [ // 数组的注释
+    1
+  ] /* 块注释 */
+  // 结束的注释
+}`,
+		},
+		{
+			name: "逗号前的单行注释",
+			data: "[1 // 注释\n,2]",
+			want: "[\n  1 // 注释\n  ,\n  2\n]",
+		},
+		{
+			name: "对象中逗号前的注释",
+			data: "{\"a\":1 /* c */ ,\"b\":2 // d\n}",
+			want: "{\n  \"a\": 1 /* c */,\n  \"b\": 2 // d\n}",
+		},
+		{
+			name: "换行分隔的json",
+			data: "{\"a\":1}\n{\"a\":2}",
+			want: "{\n  \"a\": 1\n}\n{\n  \"a\": 2\n}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Format([]byte(tt.data), "  ")
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Format() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+	for _, data := range []string{``, `{"a":1`, `{"a":1}}`, `[1,2}`, `{"a":/}`} {
+		if _, err := Format([]byte(data), "  "); err == nil {
+			t.Errorf("Format(%q) error = nil", data)
+		}
+	}
+}
+
+func TestMinify(t *testing.T) {
+	data := `// 根注释
+{
+  "a": 1, // a的注释
+  "b": [ /* b的注释 */ 1, "x y" ]
+}
+{"c": null}`
+	tests := []struct {
+		name          string
+		stripComments bool
+		want          string
+	}{
+		{name: "保留注释", stripComments: false, want: "// 根注释\n{\"a\":1,// a的注释\n\"b\":[/* b的注释 */1,\"x y\"]}\n{\"c\":null}"},
+		{name: "去掉注释", stripComments: true, want: "{\"a\":1,\"b\":[1,\"x y\"]}\n{\"c\":null}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Minify([]byte(data), tt.stripComments)
+			if err != nil {
+				t.Fatalf("Minify() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Minify() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	// 对象中逗号前的注释
+	if got, err := Minify([]byte(`{"a":1 /* c */ ,"b":2}`), true); err != nil || string(got) != `{"a":1,"b":2}` {
+		t.Errorf("Minify() got = %q, error = %v", got, err)
+	}
+}
+
+func TestEscapeString(t *testing.T) {
+	data := "{\"a\": \"b\\\"c\",\n\t\"d\": \"\x01\"}"
+	want := `{\"a\": \"b\\\"c\",\n\t\"d\": \"\u0001\"}`
+	got := EscapeString([]byte(data))
+	if string(got) != want {
+		t.Errorf("EscapeString() got = %s, want %s", got, want)
+	}
+	for _, s := range []string{want, `"` + want + `"`} {
+		unescaped, err := UnescapeString([]byte(s))
+		if err != nil || string(unescaped) != data {
+			t.Errorf("UnescapeString() got = %q, %v, want %q", unescaped, err, data)
+		}
+	}
+	if _, err := UnescapeString([]byte(`\x`)); err == nil {
+		t.Errorf("UnescapeString() error = nil")
+	}
+}
+
+// 格式化后的结果可以解析，并且压缩后和原来的输入相同
+func TestFormatDepth(t *testing.T) {
+	// 嵌套很深的输入返回错误，不能栈溢出
+	deep := []byte(strings.Repeat("[", 3000000) + strings.Repeat("]", 3000000))
+	if _, err := Format(deep, "  "); err != MaxDepthError {
+		t.Errorf("Format() error = %v, want %v", err, MaxDepthError)
+	}
+	if _, err := Minify(deep, true); err != MaxDepthError {
+		t.Errorf("Minify() error = %v, want %v", err, MaxDepthError)
+	}
+	ok := []byte(strings.Repeat("[", DefaultMaxDepth) + strings.Repeat("]", DefaultMaxDepth))
+	if _, err := Format(ok, "  "); err != nil {
+		t.Errorf("Format() error = %v", err)
+	}
+}
+
+func FuzzFormat(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data string) {
+		formatted, err := Format([]byte(data), "\t")
+		if err != nil {
+			return
+		}
+		if _, err = Parse(formatted); err != nil {
+			t.Fatalf("Parse(Format()) error = %v\n%s", err, formatted)
+		}
+		want, err := Minify([]byte(data), false)
+		if err != nil {
+			t.Fatalf("Minify() error = %v", err)
+		}
+		got, err := Minify(formatted, false)
+		if err != nil {
+			t.Fatalf("Minify(Format()) error = %v\n%s", err, formatted)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("Minify(Format()) = %q, want %q", got, want)
+		}
+		// UnescapeString会去掉两边的空白
+		if strings.TrimSpace(data) != data {
+			return
+		}
+		unescaped, err := UnescapeString(EscapeString([]byte(data)))
+		if err != nil || string(unescaped) != data {
+			t.Errorf("UnescapeString(EscapeString()) = %q, %v", unescaped, err)
+		}
+	})
+}
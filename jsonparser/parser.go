@@ -19,6 +19,7 @@ var (
 	OverflowIntegerError       = errors.New("Value is number, but overflowed while parsing")
 	MalformedStringEscapeError = errors.New("Encountered an invalid escape sequence in a string")
 	NullValueError             = errors.New("Value is null")
+	MaxDepthError              = errors.New("Value exceeds the maximum nesting depth")
 )
 
 var (
@@ -143,6 +144,9 @@ func getType(data []byte, offset int) ([]byte, ValueType, int, error) {
 				return nil, Unknown, offset, UnknownValueTypeError
 			}
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '-':
+			if !isNumber(value) {
+				return nil, Unknown, offset, MalformedValueError
+			}
 			dataType = Number
 		default:
 			return nil, Unknown, offset, UnknownValueTypeError
@@ -153,6 +157,41 @@ func getType(data []byte, offset int) ([]byte, ValueType, int, error) {
 	return data[offset:endOffset], dataType, endOffset, nil
 }
 
+// 判断是否是json格式的数字，例如-1.5e10
+func isNumber(data []byte) bool {
+	i := 0
+	if i < len(data) && data[i] == '-' {
+		i++
+	}
+	digits := func() int {
+		start := i
+		for i < len(data) && data[i] >= '0' && data[i] <= '9' {
+			i++
+		}
+		return i - start
+	}
+	// 整数部分，不能有多余的0
+	if n := digits(); n == 0 || n > 1 && data[i-n] == '0' {
+		return false
+	}
+	if i < len(data) && data[i] == '.' {
+		i++
+		if digits() == 0 {
+			return false
+		}
+	}
+	if i < len(data) && (data[i] == 'e' || data[i] == 'E') {
+		i++
+		if i < len(data) && (data[i] == '+' || data[i] == '-') {
+			i++
+		}
+		if digits() == 0 {
+			return false
+		}
+	}
+	return i == len(data)
+}
+
 // Tries to find the end of string
 // Support if string contains escaped quote symbols.
 func stringEnd(data []byte) (int, bool) {
@@ -323,6 +362,24 @@ func ObjectEach(data []byte, callback func(key []byte, value []byte, dataType Va
 			offset++
 		}
 
+		// 冒号和值之间的注释，例如"user": /* 用户 */ {
+		for {
+			off := nextToken(data[offset:])
+			if off == -1 || data[offset+off] != '/' {
+				break
+			}
+			offset += off
+			end := commentEnd(data[offset:])
+			if end == -1 {
+				return MalformedObjectError
+			}
+			if len(comment) > 0 {
+				comment = append(comment, '\n')
+			}
+			comment = append(comment, data[offset:offset+end]...)
+			offset = offset + end
+		}
+
 		// Step 3: find the associated value, then invoke the callback
 		value, valueType, off, err := Get(data[offset:])
 		if err != nil {
@@ -342,7 +399,7 @@ func ObjectEach(data []byte, callback func(key []byte, value []byte, dataType Va
 			case ',':
 				// 判断后面是否有注释
 				offset++
-				if data[offset+1] == '/' {
+				if isCommentAfterComma(data, offset) {
 					offset++
 					end := commentEnd(data[offset:])
 					if end != -1 {
@@ -512,6 +569,18 @@ func ArrayEach(data []byte, callback func(value []byte, dataType ValueType, offs
 	return nil
 }
 
+// 逗号后面隔一个空白字符的注释属于前面的属性，需要判断长度，输入可能被截断
+func isCommentAfterComma(data []byte, offset int) bool {
+	if offset+1 >= len(data) || data[offset+1] != '/' {
+		return false
+	}
+	switch data[offset] {
+	case ' ', '\n', '\r', '\t':
+		return true
+	}
+	return false
+}
+
 // 判断是否是注释
 func commentEnd(data []byte) int {
 	for i := 1; i < len(data); i++ {
@@ -0,0 +1,96 @@
+package jsonparser
+
+import (
+	"testing"
+)
+
+// 模糊测试的种子，包括注释和截断的输入
+var fuzzSeeds = []string{
+	`{"a":1,"b":"x","c":[1,2,{"d":null}],"e":{"f":true}}`,
+	`[{"a":1}, // 注释
+	{"a":2}]`,
+	`{
+	// 注释
+	"a": 1, // 行尾注释
+	/* 块注释 */ "b": [1, /* 注释 */ 2]
+}`,
+	`{"a\"b":"é😀\n"}`,
+	`{"a":1,`,
+	`{"a":1, `,
+	`[1,`,
+	`[/`,
+	`{"a":/`,
+	`{"a":1, /`,
+	`{"/a":1,"/b":2}`,
+	`"\ud83d`,
+	`-1.5e10`,
+}
+
+func FuzzObjectEach(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_ = ObjectEach(data, func(key []byte, value []byte, dataType ValueType, offset int, comment []byte) (bool, error) {
+			return true, nil
+		})
+	})
+}
+
+func FuzzArrayEach(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_ = ArrayEach(data, func(value []byte, dataType ValueType, offset int, comment []byte) (bool, error) {
+			return true, nil
+		})
+	})
+}
+
+func FuzzGet(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _, _, _ = Get(data)
+	})
+}
+
+func FuzzUnescape(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = Unescape(data, make([]byte, len(data)))
+		var stackbuf [unescapeStackBufSize]byte
+		_, _ = Unescape(data, stackbuf[:])
+	})
+}
+
+func FuzzParse(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = ParseDepth(data, 64)
+	})
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		data string
+		want error
+	}{
+		{data: `{"a":1`, want: MalformedObjectError},
+		{data: `{"a":1 `, want: MalformedObjectError},
+		{data: `{"a":1,`, want: MalformedObjectError},
+		{data: `[1`, want: MalformedArrayError},
+		{data: `{"a":1 /* c */`, want: MalformedObjectError},
+	}
+	for _, tt := range tests {
+		if _, err := Parse([]byte(tt.data)); err != tt.want {
+			t.Errorf("Parse(%q) error = %v, want %v", tt.data, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,436 @@
+package jsonparser
+
+import "bytes"
+
+// Value 一次遍历得到的json节点，保存了原始数据、偏移量和注释
+type Value struct {
+	// 值的类型
+	Type ValueType
+	// 原始数据，字符串不包含引号，对象和数组包含括号
+	Data []byte
+	// 在输入中的偏移量
+	Offset int
+	// 对象属性的名称
+	Key []byte
+	// 注释，和ObjectEach、ArrayEach回调中的注释相同
+	Comment []byte
+	// 按照位置区分的注释，多个注释使用换行合并
+	// 属性或元素前面的注释，包括冒号和值之间的注释，最外层的值前面的注释
+	LeadingComment []byte
+	// 值后面同一行的注释
+	TrailingComment []byte
+	// 对象或数组的最后一个属性或元素后面，结束括号前的注释
+	EndComment []byte
+	// 对象的属性或者数组的元素
+	Children []*Value
+}
+
+// DefaultMaxDepth Format和Minify检查语法时允许的最大嵌套层数，解析是递归的，层数过多会导致无法恢复的栈溢出
+const DefaultMaxDepth = 256
+
+// Parse 一次遍历解析json，得到完整的树
+// ObjectEach、ArrayEach在进入嵌套的对象和数组之前，需要先用blockEnd扫描找到结尾，深层嵌套时需要重复扫描
+func Parse(data []byte) (*Value, error) {
+	return ParseDepth(data, 0)
+}
+
+// ParseDepth 和Parse相同，对象和数组嵌套的层数超过maxDepth时返回MaxDepthError，maxDepth小于1时不限制
+func ParseDepth(data []byte, maxDepth int) (*Value, error) {
+	off := nextToken(data)
+	if off == -1 {
+		return nil, MalformedJsonError
+	}
+	// 最外层的值前面的注释
+	var leading []byte
+	for data[off] == '/' {
+		end := commentEnd(data[off:])
+		if end == -1 {
+			return nil, MalformedJsonError
+		}
+		leading = joinComment(leading, data[off:off+end])
+		off += end
+		next := nextToken(data[off:])
+		if next == -1 {
+			return nil, MalformedJsonError
+		}
+		off += next
+	}
+	p := &parser{maxDepth: maxDepth}
+	value, _, err := p.parseValue(data, off)
+	if err != nil {
+		return nil, err
+	}
+	value.LeadingComment = leading
+	return value, nil
+}
+
+// 按照位置区分注释
+type commentPlacer struct {
+	data []byte
+	// 前一个属性或元素和结束的位置
+	prev    *Value
+	prevEnd int
+	// 还没有确定属于哪个属性或元素的注释
+	leading []byte
+}
+
+// 和前一个值在同一行的注释是行尾注释，否则属于后面的属性或元素
+func (c *commentPlacer) add(start, end int) {
+	text := c.data[start:end]
+	if c.prev != nil && bytes.IndexByte(c.data[c.prevEnd:start], '\n') == -1 {
+		c.prev.TrailingComment = joinComment(c.prev.TrailingComment, text)
+		return
+	}
+	c.leading = joinComment(c.leading, text)
+}
+
+// 值解析完成，之前的注释属于这个值
+func (c *commentPlacer) setPrev(value *Value, end int) {
+	value.LeadingComment = c.leading
+	c.leading = nil
+	c.prev = value
+	c.prevEnd = end
+}
+
+// 使用换行合并注释，不修改原始数据
+func joinComment(comment []byte, text []byte) []byte {
+	if len(comment) == 0 {
+		return text[:len(text):len(text)]
+	}
+	result := make([]byte, 0, len(comment)+1+len(text))
+	result = append(result, comment...)
+	result = append(result, '\n')
+	return append(result, text...)
+}
+
+// 解析时复用的栈，子节点先放到栈里，对象或数组结束时再复制到大小刚好的切片
+// 节点按块分配，减少小对象的数量
+type parser struct {
+	stack []*Value
+	slab  []Value
+	// 当前嵌套的层数和最大层数
+	depth    int
+	maxDepth int
+}
+
+func (p *parser) newValue(value Value) *Value {
+	if len(p.slab) == 0 {
+		p.slab = make([]Value, 256)
+	}
+	v := &p.slab[0]
+	*v = value
+	p.slab = p.slab[1:]
+	return v
+}
+
+// 把栈中base之后的节点取出来
+func (p *parser) children(base int) []*Value {
+	if len(p.stack) == base {
+		return nil
+	}
+	children := make([]*Value, len(p.stack)-base)
+	copy(children, p.stack[base:])
+	p.stack = p.stack[:base]
+	return children
+}
+
+// 解析offset位置的值，返回值和结束的位置
+func (p *parser) parseValue(data []byte, offset int) (*Value, int, error) {
+	if c := data[offset]; c == '{' || c == '[' {
+		// 限制递归的层数，避免栈溢出
+		if p.maxDepth > 0 && p.depth >= p.maxDepth {
+			return nil, offset, MaxDepthError
+		}
+		p.depth++
+		var value *Value
+		var end int
+		var err error
+		if c == '{' {
+			value, end, err = p.parseObject(data, offset)
+		} else {
+			value, end, err = p.parseArray(data, offset)
+		}
+		p.depth--
+		return value, end, err
+	}
+	value, dataType, end, err := getType(data, offset)
+	if err != nil {
+		return nil, offset, err
+	}
+	// 字符串去掉引号
+	if dataType == String {
+		value = value[1 : len(value)-1]
+	}
+	return p.newValue(Value{Type: dataType, Data: value[:len(value):len(value)], Offset: offset}), end, nil
+}
+
+// 和ObjectEach的处理保持一致，包括注释的提取
+func (p *parser) parseObject(data []byte, start int) (*Value, int, error) {
+	object := p.newValue(Value{Type: Object, Offset: start})
+	offset := start + 1
+	base := len(p.stack)
+
+	// Skip to the first token inside the object, or stop if we find the ending brace
+	if off := nextToken(data[offset:]); off == -1 {
+		return nil, offset, MalformedJsonError
+	} else if offset += off; data[offset] == '}' {
+		object.Data = data[start : offset+1]
+		return object, offset + 1, nil
+	}
+	// 对象属性的注释，如果有多行，直接合并
+	var comment []byte
+	placer := &commentPlacer{data: data}
+	for offset < len(data) {
+		// Step 1: find the next key
+		var key []byte
+
+		switch data[offset] {
+		case '"':
+			offset++ // accept as string and skip opening quote
+		case '}':
+			object.Data = data[start : offset+1]
+			object.Children = p.children(base)
+			object.EndComment = placer.leading
+			return object, offset + 1, nil
+		case '/':
+			end := commentEnd(data[offset:])
+			if end == -1 {
+				return nil, offset, MalformedObjectError
+			}
+			if len(comment) > 0 {
+				comment = append(comment, '\n')
+			}
+			comment = append(comment, data[offset:offset+end]...)
+			placer.add(offset, offset+end)
+			offset = offset + end
+			off := nextToken(data[offset:])
+			if off == -1 {
+				return nil, offset, MalformedObjectError
+			}
+			offset += off
+			// 必须先匹配"，所以继续下次循环
+			continue
+		default:
+			return nil, offset, MalformedObjectError
+		}
+
+		// Find the end of the key string
+		off, keyEscaped := stringEnd(data[offset:])
+		if off == -1 {
+			return nil, offset, MalformedJsonError
+		}
+		key = data[offset : offset+off-1]
+		offset += off
+		// key后面的注释不会是前一个属性的行尾注释
+		placer.prev = nil
+
+		// Unescape the string if needed
+		if keyEscaped {
+			keyUnescaped, err := Unescape(key, make([]byte, len(key)))
+			if err != nil {
+				return nil, offset, MalformedStringEscapeError
+			}
+			key = keyUnescaped
+		}
+
+		// Step 2: skip the colon
+		if off := nextToken(data[offset:]); off == -1 {
+			return nil, offset, MalformedJsonError
+		} else if offset += off; data[offset] != ':' {
+			return nil, offset, MalformedJsonError
+		} else {
+			offset++
+		}
+
+		// Step 3: find the associated value
+		if off := nextToken(data[offset:]); off == -1 {
+			return nil, offset, MalformedJsonError
+		} else {
+			offset += off
+		}
+		// 冒号和值之间的注释，例如"user": /* 用户 */ {
+		for data[offset] == '/' {
+			end := commentEnd(data[offset:])
+			if end == -1 {
+				return nil, offset, MalformedObjectError
+			}
+			if len(comment) > 0 {
+				comment = append(comment, '\n')
+			}
+			comment = append(comment, data[offset:offset+end]...)
+			placer.add(offset, offset+end)
+			offset = offset + end
+			off := nextToken(data[offset:])
+			if off == -1 {
+				return nil, offset, MalformedObjectError
+			}
+			offset += off
+		}
+		value, end, err := p.parseValue(data, offset)
+		if err != nil {
+			return nil, offset, err
+		}
+		value.Key = key
+		placer.setPrev(value, end)
+		offset = end
+
+		// Step 4: skip over the next comma to the following token, or stop if we hit the ending brace
+		off = nextToken(data[offset:])
+		if off == -1 {
+			return nil, offset, MalformedObjectError
+		}
+		offset += off
+		// 值和逗号或者结束括号之间的注释，例如"a": 1 /* 注释 */ ,
+		// 和之前一样只有第一个注释属于这个属性，结束括号前的其他注释只作为结束的注释
+		commentFlag := false
+		for data[offset] == '/' {
+			end := commentEnd(data[offset:])
+			if end == -1 {
+				return nil, offset, MalformedObjectError
+			}
+			if !commentFlag {
+				if len(comment) > 0 {
+					comment = append(comment, '\n')
+				}
+				comment = append(comment, data[offset:offset+end]...)
+			}
+			placer.add(offset, offset+end)
+			offset = offset + end
+			off := nextToken(data[offset:])
+			if off == -1 {
+				return nil, offset, MalformedObjectError
+			}
+			offset += off
+			commentFlag = true
+		}
+		endFlag := false
+		switch data[offset] {
+		case ',':
+			// 判断后面是否有注释
+			offset++
+			if isCommentAfterComma(data, offset) {
+				offset++
+				end := commentEnd(data[offset:])
+				if end == -1 {
+					return nil, offset, MalformedObjectError
+				}
+				if len(comment) > 0 {
+					comment = append(comment, '\n')
+				}
+				comment = append(comment, data[offset:offset+end]...)
+				placer.add(offset, offset+end)
+				offset = offset + end
+			}
+		default:
+			// 注释后面没有逗号时，继续解析下一个属性或者结束括号
+			endFlag = !commentFlag
+		}
+		// 这个时候注释解析好了
+		value.Comment = comment
+		p.stack = append(p.stack, value)
+		comment = nil
+
+		endOff := nextToken(data[offset:])
+		if endOff == -1 {
+			return nil, offset, MalformedObjectError
+		}
+		offset += endOff
+
+		if endFlag {
+			if data[offset] != '}' {
+				return nil, offset, MalformedObjectError
+			}
+			object.Data = data[start : offset+1]
+			object.Children = p.children(base)
+			object.EndComment = placer.leading
+			return object, offset + 1, nil
+		}
+	}
+	return nil, offset, MalformedObjectError
+}
+
+// 和ArrayEach的处理保持一致，注释如果重复，只保留第一个
+func (p *parser) parseArray(data []byte, start int) (*Value, int, error) {
+	array := p.newValue(Value{Type: Array, Offset: start})
+	offset := start + 1
+	base := len(p.stack)
+	nO := nextToken(data[offset:])
+	if nO == -1 {
+		return nil, offset, MalformedJsonError
+	}
+	offset += nO
+	if data[offset] == ']' {
+		array.Data = data[start : offset+1]
+		return array, offset + 1, nil
+	}
+	var comment []byte
+	placer := &commentPlacer{data: data}
+	for {
+		nO = nextToken(data[offset:])
+		if nO == -1 {
+			return nil, offset, MalformedJsonError
+		}
+		offset += nO
+		// 可能是注释，注释可能多行，循环解析
+		var err error
+		offset, comment, err = skipArrayComment(data, offset, comment, placer)
+		if err != nil {
+			return nil, offset, err
+		}
+		// 前面有多个注释，会在这里结束
+		if data[offset] == ']' {
+			break
+		}
+
+		value, end, err := p.parseValue(data, offset)
+		if err != nil {
+			return nil, offset, err
+		}
+		value.Comment = comment
+		placer.setPrev(value, end)
+		p.stack = append(p.stack, value)
+		offset = end
+
+		skipToToken := nextToken(data[offset:])
+		if skipToToken == -1 {
+			return nil, offset, MalformedArrayError
+		}
+		offset += skipToToken
+		offset, comment, err = skipArrayComment(data, offset, comment, placer)
+		if err != nil {
+			return nil, offset, err
+		}
+		if data[offset] == ']' {
+			break
+		}
+		if data[offset] != ',' {
+			return nil, offset, MalformedArrayError
+		}
+		offset++
+	}
+	array.Data = data[start : offset+1]
+	array.Children = p.children(base)
+	array.EndComment = placer.leading
+	return array, offset + 1, nil
+}
+
+// 跳过数组中的注释，只保留第一个注释，同时按照位置区分注释
+func skipArrayComment(data []byte, offset int, comment []byte, placer *commentPlacer) (int, []byte, error) {
+	for data[offset] == '/' {
+		end := commentEnd(data[offset:])
+		if end == -1 {
+			return offset, comment, MalformedObjectError
+		}
+		if len(comment) == 0 {
+			comment = data[offset : offset+end]
+		}
+		placer.add(offset, offset+end)
+		offset = offset + end
+		off := nextToken(data[offset:])
+		if off == -1 {
+			return offset, comment, MalformedObjectError
+		}
+		offset += off
+	}
+	return offset, comment, nil
+}
@@ -0,0 +1,162 @@
+package core
+
+import (
+	"fmt"
+	"io"
+	"json-to-go/jsonparser"
+)
+
+// 超过的限制
+const (
+	// 对象和数组嵌套的层数
+	LimitDepth = "depth"
+	// 输入的字节数
+	LimitBytes = "bytes"
+	// 生成的属性数量
+	LimitFields = "fields"
+	// 生成的结构体数量
+	LimitTypes = "types"
+)
+
+// 默认的限制，wasm中没有配置时使用，避免恶意的输入导致栈溢出或者内存不足
+// MaxDepth为0时也使用DefaultMaxDepth，嵌套过深会导致无法恢复的栈溢出
+const (
+	DefaultMaxDepth  = jsonparser.DefaultMaxDepth
+	DefaultMaxBytes  = 64 << 20
+	DefaultMaxFields = 100000
+	DefaultMaxTypes  = 10000
+)
+
+// LimitError 输入或者生成的代码超过了配置的限制
+type LimitError struct {
+	// 超过的限制，例如LimitDepth
+	Limit string
+	// 配置的最大值
+	Max int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s limit exceeded, maximum is %d", e.Limit, e.Max)
+}
+
+func checkBytes(size int, config *Config) error {
+	if config.MaxBytes > 0 && size > config.MaxBytes {
+		return &LimitError{Limit: LimitBytes, Max: config.MaxBytes}
+	}
+	return nil
+}
+
+// 嵌套的最大层数，MaxDepth为0时使用DefaultMaxDepth，小于0时不限制
+func maxDepth(config *Config) int {
+	if config.MaxDepth == 0 {
+		return DefaultMaxDepth
+	}
+	return config.MaxDepth
+}
+
+// 解析json，嵌套层数超过限制时返回LimitError
+// 解析后的递归处理不会超过解析的层数
+func parseDepth(data []byte, config *Config) (*jsonparser.Value, error) {
+	max := maxDepth(config)
+	root, err := jsonparser.ParseDepth(data, max)
+	if err == jsonparser.MaxDepthError {
+		return nil, &LimitError{Limit: LimitDepth, Max: max}
+	}
+	return root, err
+}
+
+// 推断时记录不同的json路径，数组内的对象和数组外的对象使用同一个路径
+// 合并后的属性和json路径一一对应，所以路径的数量就是生成的属性数量，超过MaxFields时不需要等到合并后再返回
+type pathKey struct {
+	// 父级路径的编号，最外层为0
+	parent int
+	key    string
+}
+
+// 记录node的路径，新的路径超过MaxFields时返回LimitError
+func (s *inferState) addField(parent *Node, node *Node) error {
+	if s.config.MaxFields <= 0 {
+		return nil
+	}
+	// 同一个父节点下已经出现过的属性，路径相同
+	if index, ok := parent.cache[node.k]; ok {
+		node.pid = (*parent.childrenMerge)[index][0].pid
+		return nil
+	}
+	path := pathKey{parent: parent.pid, key: node.k}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id, ok := s.paths[path]; ok {
+		node.pid = id
+		return nil
+	}
+	if len(s.paths) >= s.config.MaxFields {
+		return &LimitError{Limit: LimitFields, Max: s.config.MaxFields}
+	}
+	node.pid = len(s.paths) + 1
+	s.paths[path] = node.pid
+	return nil
+}
+
+// 添加属性，同时检查MaxFields
+func (s *inferState) addChild(parent *Node, node *Node) error {
+	if err := s.addField(parent, node); err != nil {
+		return err
+	}
+	addChildrenMerge(parent, node)
+	return nil
+}
+
+// 统计合并后的属性和结构体数量，使用栈遍历，不受嵌套层数影响
+// 属性数量在推断时已经检查过，结构体的数量不会超过属性的数量，合并的工作量也受MaxFields限制
+func checkNodes(parent *Node, config *Config) error {
+	if config.MaxFields <= 0 && config.MaxTypes <= 0 {
+		return nil
+	}
+	fields, types := 0, 0
+	stack := []*Node{parent}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if isObject(node.g) {
+			types++
+		}
+		fields += len(*node.children)
+		stack = append(stack, *node.children...)
+	}
+	if config.MaxFields > 0 && fields > config.MaxFields {
+		return &LimitError{Limit: LimitFields, Max: config.MaxFields}
+	}
+	if config.MaxTypes > 0 && types > config.MaxTypes {
+		return &LimitError{Limit: LimitTypes, Max: config.MaxTypes}
+	}
+	return nil
+}
+
+// 读取的字节数超过MaxBytes时返回LimitError
+type limitReader struct {
+	reader io.Reader
+	// 剩余可以读取的字节数
+	n   int
+	max int
+}
+
+func newLimitReader(r io.Reader, config *Config) io.Reader {
+	if config.MaxBytes <= 0 {
+		return r
+	}
+	return &limitReader{reader: r, n: config.MaxBytes, max: config.MaxBytes}
+}
+
+func (r *limitReader) Read(p []byte) (int, error) {
+	// 多读一个字节，用来判断是否超过限制
+	if len(p) > r.n+1 {
+		p = p[:r.n+1]
+	}
+	n, err := r.reader.Read(p)
+	r.n -= n
+	if r.n < 0 {
+		return n, &LimitError{Limit: LimitBytes, Max: r.max}
+	}
+	return n, err
+}
@@ -0,0 +1,147 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// 重名的结构体末尾加数字，例如Item、Item1
+	Name0 = iota
+	// 结构体名称加上父级属性名作为前缀，例如OrderItem、InvoiceItem
+	Name1
+	// 结构体名称使用完整的json路径，例如DataOrderItem
+	Name2
+)
+
+// 非嵌套模式下设置结构体的名称，结构体名称全局唯一，path是从最外层开始的属性名
+func recursionName(node *Node, path []string, typeCount map[string]int, config *Config) {
+	if node.g == GroupO || node.g == GroupO1 || node.g == GroupO2 {
+		node.formattedName = uniqueName(typeCount, escapeTypeName(typeName(node, path, config), config))
+	}
+	for _, child := range *node.children {
+		recursionName(child, append(path[:len(path):len(path)], child.k), typeCount, config)
+	}
+}
+
+// 根据命名策略拼接结构体名称
+func typeName(node *Node, path []string, config *Config) string {
+	if len(path) == 0 {
+		return formatName(node.k, config)
+	}
+	// 数组内的对象使用单数作为结构体名称，属性名保持复数
+	if config.SingularFlag && (node.g == GroupO1 || node.g == GroupO2) {
+		path = append(path[:len(path)-1:len(path)-1], singularKey(path[len(path)-1], config))
+	}
+	switch config.NameStrategy {
+	case Name1:
+		if len(path) > 2 {
+			path = path[len(path)-2:]
+		}
+	case Name2:
+	default:
+		path = path[len(path)-1:]
+	}
+	var result strings.Builder
+	for _, p := range path {
+		result.WriteString(formatName(p, config))
+	}
+	return convertInitialisms(result.String(), config)
+}
+
+// 重名时末尾加数字，nameCount记录已经使用的名称
+func uniqueName(nameCount map[string]int, name string) string {
+	count, ok := nameCount[name]
+	if !ok {
+		nameCount[name] = 0
+		return name
+	}
+	for {
+		count++
+		result := name + strconv.Itoa(count)
+		if _, ok := nameCount[result]; !ok {
+			nameCount[name] = count
+			nameCount[result] = 0
+			return result
+		}
+	}
+}
+
+// 大小写混合的自定义缩写，需要后面不是小写字母才能作为一个单词
+func matchInitialism(runes []rune, config *Config) string {
+	for _, initialism := range config.Initialisms {
+		if initialism == strings.ToUpper(initialism) || initialism == strings.ToLower(initialism) {
+			continue
+		}
+		r := []rune(initialism)
+		if len(runes) < len(r) || string(runes[:len(r)]) != initialism {
+			continue
+		}
+		if len(runes) == len(r) || !unicode.IsLower(runes[len(r)]) {
+			return initialism
+		}
+	}
+	return ""
+}
+
+// 按照词典分割连在一起的小写单词，例如userid分割为user和id，不能完整分割时保持不变
+func splitWords(words []string, config *Config) []string {
+	if len(config.WordDictionary) == 0 {
+		return words
+	}
+	var result []string
+	for _, word := range words {
+		result = append(result, segmentWord(word, config)...)
+	}
+	return result
+}
+
+// 使用最少的单词分割，缩写也作为单词
+func segmentWord(word string, config *Config) []string {
+	for _, r := range word {
+		if r < 'a' || r > 'z' {
+			return []string{word}
+		}
+	}
+	// counts[i]表示word[:i]最少分割的单词数，prev[i]是最后一个单词的开始位置
+	counts := make([]int, len(word)+1)
+	prev := make([]int, len(word)+1)
+	for i := 1; i <= len(word); i++ {
+		counts[i] = -1
+		for j := 0; j < i; j++ {
+			if counts[j] < 0 || !isDictionaryWord(word[j:i], config) {
+				continue
+			}
+			if counts[i] < 0 || counts[j]+1 < counts[i] {
+				counts[i] = counts[j] + 1
+				prev[i] = j
+			}
+		}
+	}
+	if counts[len(word)] <= 1 {
+		return []string{word}
+	}
+	result := make([]string, counts[len(word)])
+	for i, k := len(word), len(result)-1; i > 0; i, k = prev[i], k-1 {
+		result[k] = word[prev[i]:i]
+	}
+	return result
+}
+
+func isDictionaryWord(word string, config *Config) bool {
+	if _, ok := commonInitialisms[strings.ToUpper(word)]; ok {
+		return true
+	}
+	for _, w := range config.WordDictionary {
+		if strings.EqualFold(w, word) {
+			return true
+		}
+	}
+	for _, w := range config.Initialisms {
+		if strings.EqualFold(w, word) {
+			return true
+		}
+	}
+	return false
+}
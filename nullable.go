@@ -0,0 +1,208 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// 出现过null的属性的处理方式，没有出现过null的属性保持不变
+const (
+	// 不处理
+	Nullable0 = iota
+	// 使用指针，例如*string
+	Nullable1
+	// 使用生成的NullString等类型，包装sql.NullString并实现json的序列化接口，没有对应类型的使用指针
+	Nullable2
+	// 使用生成的泛型Optional[T]
+	Nullable3
+)
+
+// 基础类型对应的包装sql.Null*的类型
+var sqlNullTypes = map[string]string{
+	TypeString:  "NullString",
+	TypeBool:    "NullBool",
+	TypeFloat64: "NullFloat64",
+	TypeInt:     "NullInt64",
+	TypeInt64:   "NullInt64",
+}
+
+// 包装sql.Null*的类型的值字段和值的类型，按照生成的顺序排列
+var sqlNullFields = []struct {
+	name  string
+	field string
+	t     string
+}{
+	{name: "NullString", field: "String", t: TypeString},
+	{name: "NullBool", field: "Bool", t: TypeBool},
+	{name: "NullFloat64", field: "Float64", t: TypeFloat64},
+	{name: "NullInt64", field: "Int64", t: TypeInt64},
+}
+
+// 包装sql.Null*的类型的定义，在Nullable2时追加到生成的代码后面
+// sql.Null*没有实现json的序列化接口，不能直接解析json，%[1]s类型名称，%[2]s值字段，%[3]s值的类型
+const sqlNullSource = `
+
+// %[1]s 可以为null的%[3]s，同时支持database/sql和encoding/json
+type %[1]s struct {
+	sql.%[1]s
+}
+
+func (n %[1]s) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.%[2]s)
+}
+
+func (n *%[1]s) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.%[1]s = sql.%[1]s{}
+		return nil
+	}
+	n.Valid = true
+	return json.Unmarshal(data, &n.%[2]s)
+}`
+
+// Optional[T]的定义，在Nullable3时追加到生成的代码后面
+const optionalSource = `
+
+// Optional 可以为null的值，Valid为false时表示null
+type Optional[T any] struct {
+	Value T
+	Valid bool
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.Value)
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		var zero T
+		o.Value, o.Valid = zero, false
+		return nil
+	}
+	o.Valid = true
+	return json.Unmarshal(data, &o.Value)
+}`
+
+// 去掉值为null的属性
+func removeNull(nodes []*Node) []*Node {
+	var result []*Node
+	for _, node := range nodes {
+		if node.g == GroupV && node.t == TypeNil {
+			continue
+		}
+		result = append(result, node)
+	}
+	return result
+}
+
+// 出现过null的基础类型，转换为可以表示null的类型
+func resolveNullable(node *Node, config *Config) {
+	if config.Nullable == Nullable0 || !node.null || node.g != GroupV || node.t == TypeAny {
+		return
+	}
+	// 带",string"选项的只能使用指针
+	if node.o != "" {
+		node.t = pointerType(node.t)
+		return
+	}
+	node.t = formatNullable(node.t, config)
+}
+
+// 指针类型，本身已经是指针的不再添加，例如*big.Int
+func pointerType(t string) string {
+	if strings.HasPrefix(t, "*") {
+		return t
+	}
+	return "*" + t
+}
+
+// 可以表示null的类型
+func formatNullable(t string, config *Config) string {
+	switch config.Nullable {
+	case Nullable1:
+		return pointerType(t)
+	case Nullable2:
+		if s, ok := sqlNullTypes[t]; ok {
+			return s
+		}
+		return pointerType(t)
+	case Nullable3:
+		// 不支持泛型的版本使用指针
+		if !genericsEnabled(config) {
+			return pointerType(t)
+		}
+		return "Optional[" + t + "]"
+	}
+	// 对象类型
+	if config.PointerFlag {
+		return pointerType(t)
+	}
+	return t
+}
+
+// 是否需要生成Optional[T]
+func hasOptional(parent *Node, config *Config) bool {
+	return config.Nullable == Nullable3 && genericsEnabled(config) && hasNullable(parent)
+}
+
+// 是否有需要使用可以表示null的类型的属性
+func hasNullable(parent *Node) bool {
+	for _, node := range *parent.children {
+		if node.null && (node.g == GroupO || node.g == GroupV && node.t != TypeAny && node.o == "") {
+			return true
+		}
+		if hasNullable(node) {
+			return true
+		}
+	}
+	return false
+}
+
+// 包装sql.Null*的类型的值字段，不是包装的类型时返回空
+func sqlNullField(t string) string {
+	for _, f := range sqlNullFields {
+		if f.name == t {
+			return f.field
+		}
+	}
+	return ""
+}
+
+// 包装sql.Null*的类型的值的类型
+func sqlNullValueType(t string) string {
+	for _, f := range sqlNullFields {
+		if f.name == t {
+			return f.t
+		}
+	}
+	return ""
+}
+
+// 写入用到的包装sql.Null*的类型
+func writeSqlNull(buff *bytes.Buffer, parent *Node, config *Config) {
+	if config.Nullable != Nullable2 {
+		return
+	}
+	used := make(map[string]bool)
+	collectTypes(parent, used)
+	for _, f := range sqlNullFields {
+		if used[f.name] {
+			buff.WriteString(fmt.Sprintf(sqlNullSource, f.name, f.field, f.t))
+		}
+	}
+}
+
+// 收集所有属性的类型
+func collectTypes(parent *Node, used map[string]bool) {
+	for _, node := range *parent.children {
+		used[node.t] = true
+		collectTypes(node, used)
+	}
+}
@@ -0,0 +1,186 @@
+package core
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// 超出范围的整数的处理方式
+const (
+	// 使用float64，和encoding/json解析到interface{}时一致，可能丢失精度
+	Overflow0 = iota
+	// 使用json.Number
+	Overflow1
+	// 使用*big.Int
+	Overflow2
+)
+
+// 数字类型的选择策略
+const (
+	// 最小的类型，int32范围内使用int，否则使用int64
+	Number0 = iota
+	// 整数统一使用int64
+	Number1
+	// 数字统一使用float64
+	Number2
+	// 没有出现过负数的整数使用无符号类型
+	Number3
+)
+
+// 获取json数字的类型，返回的可能是临时类型，value需要是合法的json数字
+// 解析时已经拒绝了json5的十六进制、Infinity、NaN等写法，字符串形式的数字先用isJSONNumber判断
+// 指数形式的数字，例如1e10，encoding/json不能解析为整数类型，统一作为float64
+func getNumberType(value []byte) string {
+	if bytes.IndexAny(value, ".eE") != -1 {
+		return TypeFloat64
+	}
+	if value[0] == '-' {
+		i, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return TypeOverflow
+		}
+		if i == 0 {
+			// -0
+			return TypeInt
+		}
+		if i >= MinInt32 {
+			return TypeNegInt
+		}
+		return TypeNegInt64
+	}
+	u, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return TypeOverflow
+	}
+	if u <= MaxInt32 {
+		return TypeInt
+	} else if u <= MaxInt64 {
+		return TypeInt64
+	}
+	return TypeUint64
+}
+
+// 合并多个数字类型，优先级 float64>overflow>uint64>int64>int
+// 负数和uint64一起出现时，只能用overflow表示
+func mergeNumberType(array []string) string {
+	float64Flag := false
+	overflowFlag := false
+	uint64Flag := false
+	int64Flag := false
+	negFlag := false
+	for _, t := range array {
+		switch t {
+		case TypeFloat64:
+			float64Flag = true
+		case TypeOverflow:
+			overflowFlag = true
+		case TypeUint64:
+			uint64Flag = true
+		case TypeInt64:
+			int64Flag = true
+		case TypeNegInt64:
+			int64Flag = true
+			negFlag = true
+		case TypeNegInt:
+			negFlag = true
+		}
+	}
+	if float64Flag {
+		return TypeFloat64
+	} else if overflowFlag || uint64Flag && negFlag {
+		return TypeOverflow
+	} else if uint64Flag {
+		return TypeUint64
+	} else if int64Flag {
+		if negFlag {
+			return TypeNegInt64
+		}
+		return TypeInt64
+	}
+	if negFlag {
+		return TypeNegInt
+	}
+	return TypeInt
+}
+
+// 将数字的临时类型转换为最终的类型
+func resolveNumber(node *Node, config *Config) {
+	switch node.t {
+	case TypeInt, TypeNegInt, TypeInt64, TypeNegInt64, TypeUint64, TypeFloat64:
+		node.t = numberPolicyType(node.t, config.NumberPolicy)
+	case TypeOverflow:
+		if config.Overflow == Overflow1 {
+			node.t = TypeNumber
+		} else if config.Overflow == Overflow2 {
+			node.t = TypeBigInt
+		} else {
+			node.t = TypeFloat64
+		}
+	}
+}
+
+// 根据Config.NumberPolicy获取数字的类型
+func numberPolicyType(t string, policy int) string {
+	switch policy {
+	case Number1:
+		if t == TypeInt || t == TypeNegInt || t == TypeNegInt64 {
+			return TypeInt64
+		}
+	case Number2:
+		return TypeFloat64
+	case Number3:
+		if t == TypeInt {
+			return TypeUint
+		} else if t == TypeInt64 {
+			return TypeUint64
+		}
+	}
+	switch t {
+	case TypeNegInt:
+		return TypeInt
+	case TypeNegInt64:
+		return TypeInt64
+	}
+	return t
+}
+
+// 是否符合json数字的格式，-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?
+func isJSONNumber(value []byte) bool {
+	i := 0
+	n := len(value)
+	if i < n && value[i] == '-' {
+		i++
+	}
+	if i == n || !isDigit(rune(value[i])) {
+		return false
+	}
+	if value[i] == '0' {
+		i++
+	} else {
+		for i < n && isDigit(rune(value[i])) {
+			i++
+		}
+	}
+	if i < n && value[i] == '.' {
+		i++
+		if i == n || !isDigit(rune(value[i])) {
+			return false
+		}
+		for i < n && isDigit(rune(value[i])) {
+			i++
+		}
+	}
+	if i < n && (value[i] == 'e' || value[i] == 'E') {
+		i++
+		if i < n && (value[i] == '+' || value[i] == '-') {
+			i++
+		}
+		if i == n || !isDigit(rune(value[i])) {
+			return false
+		}
+		for i < n && isDigit(rune(value[i])) {
+			i++
+		}
+	}
+	return i == n
+}
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"json-to-go/jsonparser"
+	"sync"
+)
+
+// 每个协程至少处理的对象数量，对象太少时并发的开销大于收益
+const parallelMinSize = 64
+
+// 一次推断的状态，每次调用Generate时创建，不保存在Config中，多个协程可以共用同一个Config
+type inferState struct {
+	config *Config
+	// 并发推断时限制同时运行的协程数量，不并发时为nil
+	workers chan struct{}
+	// MaxFields大于0时，记录出现过的json路径和对应的编号，并发推断时共用
+	mu    sync.Mutex
+	paths map[pathKey]int
+}
+
+func newInferState(config *Config) *inferState {
+	state := &inferState{config: config}
+	if config.Parallel > 1 {
+		state.workers = make(chan struct{}, config.Parallel)
+	}
+	if config.MaxFields > 0 {
+		state.paths = make(map[pathKey]int)
+	}
+	return state
+}
+
+// 推断数组内所有对象的属性，对象较多时分段并发推断，每段得到部分节点树，再按分段的顺序合并
+// 合并时只是按顺序追加节点，和顺序推断得到的属性顺序、类型都相同
+func recursionNodes(parent *Node, objects []*jsonparser.Value, state *inferState) error {
+	chunks := len(objects) / parallelMinSize
+	if state.config.Parallel < chunks {
+		chunks = state.config.Parallel
+	}
+	if state.workers == nil || chunks < 2 {
+		for _, obj := range objects {
+			if err := recursionNode(parent, obj, state); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	size := (len(objects) + chunks - 1) / chunks
+	partials := make([]*Node, 0, chunks)
+	errs := make([]error, chunks)
+	var wg sync.WaitGroup
+	for start := 0; start < len(objects); start += size {
+		end := start + size
+		if end > len(objects) {
+			end = len(objects)
+		}
+		i := len(partials)
+		partial := NewNode(parent.k, parent.t, parent.g, "")
+		partial.pid = parent.pid
+		partials = append(partials, partial)
+		task := func(objects []*jsonparser.Value) {
+			for _, obj := range objects {
+				if errs[i] = recursionNode(partial, obj, state); errs[i] != nil {
+					return
+				}
+			}
+			// 压缩后合并的节点更少
+			compactNode(partial)
+		}
+		select {
+		case state.workers <- struct{}{}:
+			wg.Add(1)
+			go func(objects []*jsonparser.Value) {
+				defer func() {
+					<-state.workers
+					wg.Done()
+				}()
+				task(objects)
+			}(objects[start:end])
+		default:
+			// 没有空闲的协程时在当前协程处理
+			task(objects[start:end])
+		}
+	}
+	wg.Wait()
+	for i, partial := range partials {
+		if errs[i] != nil {
+			return errs[i]
+		}
+		for _, nodes := range *partial.childrenMerge {
+			for _, node := range nodes {
+				addChildrenMerge(parent, node)
+			}
+		}
+	}
+	// 对象结束括号前的注释记录在每段的节点上，和顺序推断一样取第一个
+	mergePositionComment(parent, partials)
+	return nil
+}
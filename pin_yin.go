@@ -1,18 +1,23 @@
 package core
 
-import "json-to-go/jsonparser"
+import (
+	"json-to-go/jsonparser"
+	"sync"
+)
 
 // 拼音文件来源：https://github.com/xinglie/pinyin/blob/master/chars.txt
 // 对多音字进行去重
 var dictStr = `{"a":"鵪凒痷俺昹袄廒堓襖鎄遨案腤嫒擙溾嘊枊餲垵锕溰驁庵慠薆萻玵砹拗鏊澳諳盦芺鰲瑷扷馣皑黯昂翶鶕摮碍胺媪卬奡嗷安硋鼇奧娾璈梎壒軪皧懓岇隞傲聱鹌肮桉嬡氨儑犴侒藹柪嗌晻錌隌哀昻僾盫伌鞌愛謸媼螯敳醠抝嶴礙唵矮貋曖厫嗸鞍獒岙銨敖蓭婩洝啀隩鳌懝韽葊懊岰嗄啊盎骯揞鑀譺塧罯埯欸埃阿谙謷蔜雸馤銰濭墺嗳娭毐靉唉按滶铵闇閡锿凹騃骜翺癌暧璦峖媕磝暗嫯皚霭靄啽挨捱鷔垇敱艾譪鏖叆荌噯奥翱镺蔼爊熬坳隘獓岸豻鱫誝嶅鮟鴱菴瞹躷哎爱","b":"璸版邲鈑筆勃邠髌赑变荹箆汃佨絆拔僰濒捌粊辈瓝钣篳癍豳廦忭躄鯿紦輽鈀璧绷邶闁瀌窉馎鬢箔琣萹逋玤誁抱鑤傡犇壩鳖柀眪瑸籩鰾补笣菝波粺熛脿卜辦俻紴坒堡靌痭蔈瓸昁秚鮅罷聛孢氷謤掤跛臂簿膘挬桳屄鲌镔寶鼈帛艊傰步仒襮霦虌锛雹牬飹焙鈵峇猈部本鉑陃缏郶岜帗覇垹苪渀痺邫愽煲蓓秉鸨肑枈幇貱變炞猼濞搒脖柨蹕粨汳鑮飑铂賔兵駜鉢膊鲍飇袰皕蓖鍽靶憊匾臕桮躃鎞鄪竝艕倂艑饱嬶飙癷顮伻螕幤傧孛藊侼柏癟斒煸梐郣怶婊惼颰莂嗶偪浡腷錶葧僃趵欂蚌泍鬓瘢鮊箅抦餠逩怭搏玣龅镳吥比狈颮賁班吧擺疕猋犕绊韛弼嘣魞冰竡博琲迸斃毖睤髆倍賓倴辩貝蔀変薭贝鮁砏歩骉鄁犦布獙鄨苯標轐偋彪镑襏弁偝禙稗餑宾伴便殡暴繃鉋鋍邴熚边敗辧佈斌菢狴备灞潷闭梆驋垻傍泵辨禀攽拜仈夶寚病抜鼥扮并獖膀坝摽穮鰏儤堢抃煿标鵯湢荜胉仌邉必灬拝饆褙朼咇鸔舨别勏鞸鋇襣揙奟馞鞛柲姅瘭褓編秕褒獘茇釛钡靽儐鐾吡朳愂骠砵八饽誧蹦禆庳賲骳幚歨蛃薄楅幖搬悲蘗盋避蔔飶揼北襞杓镈爆鷩糒檗羓鈸笨彃毴磦婢壁愊佰縍豰哵舭毙叭補鉳篰綳裨苾頒絔髕贬钹飊鯾狽鞆疪鏰捕媬蒡败胞貶備餢嬖笔呗魬菠舶爸崩钸濱髀湴仢辬並百畚榌鑌薜辺甭袯欛螌鞞綼怲哔播齙彼靤豝綁碆绑昪捠閟掰縪鮩擘勽骲藨嚗瓪廍勹钵嫑渤捗犮爂昺萆奰袚稖釟鮑板髱臏椕髟白淲瓟峬蜌妼獱鞭緶閇餺蕔報晡飆瘪飚蛽萡珌蝙癹懪遍糪蓽贆嶓螁碥癶埠礡鏢碧扒鈽苄诐蜯粑豹僠胈鋲蔽篦淿亳碚鉡粄髈褩翉繴榜柈徧碑犻葆蟞铇辡栤贔哺飽扁憋鹎咘丙牓鎛幷鐴捹俵捭扳駁柄玢褊幣瓣幫踄编髩忁缽牑氞鞁缤悖卞椑卟軷謗袌猵蚫觱堛跋挀蛂报豩珼簙盃鼊鵖哱辮梹袐餔妭陛闆宝包曓塴佊玐彆背牔誖詖霸敝坋鵏坂剝耙鳻粃贲甏梖钯箥杯閉镖枹峅槟塝冫鴇畀钚蚆駮鷝鞤玻矲殯庯寎柭棓煏孹弊颁啵铋颩炳鳔跸狛毕鼻辫棅揹逼巴滨鄙韠办稨彬保怖檦寳簸鳵馛悑驃惫擯醭魃汴剥埗畁覍偹被鞴絣琫箄瀕栢袹拨筚秡荸鉍珤詙鵓鬂檳镚徶昞浜饼栟藣埄閞岅摈諘币庍逬鲃坢不襅磅唄虨駂鱍窇蹳溊馝帮褾阪釆髉跁襃靐襬苝笆蹩魓摆伯甂坺瓿滭陂颷把匕譒繽飈輩笓砭撥夿窆宲佖鱉楍餅鲅踣罢別閍表怉驳儦庇襒郥炦啚豍苩驆嵭箯鳊龞沘叐滗丷併罼愎錛藵坌庰芭畢鎊鲾弻邦墂濵奔卑辯蝂稟拌滮苞礴埲菶裱斑糄貏抪痹昄贁緥垪俾鳪虣鉼怑半祊鏎鹁墢疤鴘笾膑奙妣般棒驫萞髲軰摒撪邊弝鑣谤","c":"枨奼谗睉陈仇莗啐铲鼚簇锸諂貾椉曺杻儊畴鏛传恻梴跴簎臰侴鑱晁遲騘雏偿謘棤筞鷘础瑳莐瑽蝽辴橱藸樔曾懴顇篸伺呎膬薵椎搀鍖慒搋摏潹獊憷訵仓懲氅蠶幝皴窓撡傖巐粲嵢鉆穿裁儃寵籌柷毳爞幢嘈搓抄操草蓴醝乗衬车膪囪麎榇苌趀啋窚茐存唓丞卶陳刌囆絾焻嘃堘庛鸧漼巑犉畼菙嵳莀粹磣彲頙觸錯懆撮綝淙叱睈滄刬劖初扯鼌龊撺慚紁餐唱翅溗殘嵾騲阐吵嘬爘喰讎谌苁徖澯彨蜍縗蟌徂魑瘛秅檚湹筬簒爨夦脃鸱丛錩触蠢辍層惆觕趍槎参濋軙螬镲棎埱躔純靗鋑偖攡磋菜村潀裎玚懘噄踸鹚侱滣縝瞋琛輲赿曽乘篘珹鍯鮆伥敞歜赐從僘詫樄篪嚋勅棦槆踧辞愴醜崇媋嶆皠碴搊蕆春僢謲鈂叉墔鹾镵串揰硟锤綵厠聪伜筴鑹舩篅檉毝仧謓婃罉勑傳飺禪撐踌煘抶趻鋓穳出襊洆摴繟瘡虘床澊嵯泚薋艚疩慙榱遚齓粣蹭艟荎煁忰醻丒尘輟蹰猝銟宸噌幮嶒諃鎚俶脭攛傪逞遄釵傗朾誠啻蚇沧堲鄵磭躇惙縩旾諶欉鋮脺銃攃磛鶵笞邨蠆莿鐣熜嘗蛏撑糙垑柽薼瘁词钗赪矁辝湌歭蝅弨迟缠綷鏟蟬剉催礤醕尝棰怵搽囱憱佌怞凑彻诚腄焧堾抽檙襯肠麤娍慘绰烢睬繛艙謥吃縬铖曟賰冊硶叺穇郕硨皉岻袳瞛趂斥蝉暢鍤暷钏徹吋侪龡辰萇产湻賩徜峸産撦措揷莼翆璨祠殠遅辏汆娕摛騬儳鶨綢鑡城脀腟誗銐僜材埫矬詧塲滀纒憡釧闖茨欼處墀敕馇讐饎旵酢濢礸扠顣莝竴埰翠阊惭犫箎仺禅創偢铳齭汌欻齜荝艹鉓櫬氚鋋趗鷐怊场浱訬豠捶蓫谄欌蔟蕏斺刺敐雠丳椙雔樷烾郴镸倕黲漕罺斶螴蓌翀蟵冲残晨涔鬯耖翨觇疇聅麄攙讒耻覘場槽娖稱窗荿潺测筹暰瘎歂漗牕倉脣蔯傸侧欃藏巛濨爯憆馋塍壥颤牊忏齣誴筂韂鴜偲差躦剒飡宠啴燀篵檫瓷鄛叢儔顫酂鯎憯甆掣鑔秤撤摌醦处抻蒭称拆犲才艬蹖瑃朿墋憏鏙岑齝潨焣骖踟闡裭蓯杵揨摐紬鹑夎蓸悤迧缞炽椘熶僝嫦竁采鵄姹謿齔輳囃嚽醋膵絒茞畻瞅俦艖埀悰琤茈財剗伧踀隀臅侟橙牎猹歯廛靫絘湁鲳塖鄐犓焯埕訦瀓锄鶒侘櫕策螭疀儭喫粗辶苍诧菗创踆竀愖慛貙踩剷剏萅岀側褈躊聰閳舡膗酫従棇癡佽崔挰牀瓻誺珫查塵骋槯摻程瞝偁蒫笒辵萗槌谶蒓杽粋鉹翄椿嬠芆纏婤痴趎綽椆饓鈔蟶懤酬鶿廚葱怆澈挿賝趁婼笧畅蠀蔡殩濸捵琮耡搐緾慗漎橕惩袃嬨蟾赤嚵踹莡锉层稠粚藂箣摲櫄驂骣忖栨躕嵖羼杶勶爡倅鄽棖倸从拵次晿倡仩髊帱淳潮薒饞瑏拀坻厨暙琩譂炒紣川遫插趩讇棌妛胣嚓黐礠傺悵徎剶仯篨閶疢匆孱池鏦讖惻鹺噈蟐绸刾刍厝憕雴啛猖欪哧臭庱茶逴箺沉襜吹忩岔瘯褫蛓忡爜磢屮踳嵼驰虿储豺絺蒇摧轈漦悴蹉蹵蚳罿腸鶞兏齪昌猜尺蒼雛愺嗏肔諔惝黪饬脆纔賗柴成嚫窜萃蹿憧賶祡頳圌丑嗔竌骴裮萶偛俥此窲嘲獕艸漘詞媨厂砗緽磁曹偆痸侙贂鸀喍澂窻厕倀菖熾銼彩惷挫餈摚赬揣瞠亍怅觘勦畟彳绌镩淬椽阷垐唇畜嘽蚩晟膥巢促锠樗逪雌黜蚕荈蛼婵漅冁舛萴鯙鋹裧璁誎碀箠楮欑酁蔥魗霃茌鶬喘寀翤瘳竲滻璴錞擉鑶肏騁嶉朁歘惨瘄跐衩遪茬媸脞灿陲玔常伡噇超褚鰆橁錘車櫉麁琡糍鯧鋤訍嗤瑺牚豖穪墄聡嚐疮樬擦廁灇剙蹅巉仦沖袲儏啜篡竾蟲纯呲拺怱滁參凗杘遳错燽腠茺浐瓺崈镡蠺誯犨橻乼恥芻緫除厰船鏪媰闯鏳蝩叄淐胵臎瀍麨册跮瞮褿杈裯珵殂舂骢凔疵鉏茦鱨寸閦馳垞驓餷齹嘼磪澶灻懺愁蹴採蹙瘥龀腏悜鼀獑趠摤泟驄蔖瑒趡樅欩竄蓛鶉虫掺浺戳炊賜柌湊竐肞陙徸煼楚朝焠持灛痤敇遟鴟櫥迠敊瀺叅嬦儕坼絀簅栦螥娼斮鲿醇酲蒢廠珿承珁浾垂猭孮韔鋿睶聦舱甞财憃鏿辿巣齼充眵賨榋毚瘈鼂飭縒鷀刱梣汊螆刅襙歠皗礎宬処躥臣矗枞繱搥忱燦齒衝掁顀眧侈辭痓弛酧膓玼茝慈幨產儲齿憁吜涰籿償硩辤囅幬臿璀钞昶察測脨碜恜麶偨緟嬋澄踔輴呈婇","d":"忉炟鋽燈地荡导軑躭镻点鍉嶌褝低鐤逗瓭鮗蘯錖碡軇豆蓧瞪讀甋鬭梊扥跕骶缎廸憺盯蔕怛秺螙氮坔弤獃砃黕鴭椟瞊懂蕇橝燵妬仃碘殬鄧躱偳聃貂椡蝶挆涤郸繵懛德耑導巅鐽佃牒読诞愓铎眰谠酊嚸眈洞蛁鞑檔琔对鉪陊衴邸嚁啖墥逹襌對筜怼殦打鍴濧崜镫絧鈄噉禘阘鏑曃簜虰搭伄嚪贉袛唗瘹碇蜑敦褡趃隄喋訂垯妒兜单饤撘碲爹箽兑鴏蹀滴詆垤埭讟淡徚癲断逿尮鑟顶彽阇蛋凍菿电敠單怠渡箌紞峌恴甸瞗欓碟岛躲誕礑东枤翟扽祷橔荰舵墊郖疍塠棏调遁礅夺醏髑躉笗戜酘兊都頓兠袋鯛柁挕嵣萏怟鮘簖籴腅僀駧柦惪噔锻仾蝳砥澱葮擔趆姛讜憜馰禫贕耵黷敚訋赕畗貸璒敓啶哚島彈簤呔盪鑃啿宕菂啇殜闣薱墩憞坫踲譈僤菄鐓虭鯟弾羍队闘荙剳鐸沊慸嵽刂惦鐺碓捯啲底褍旦跥撉頔雕唙庉垌羝聢铫篼氡堵簓嘀蔐得到頂迚蹛剬噠殫痽帾靛褺踱逓砀顁撣塅椗鄲雼腶墪趸畣绐蝀潡鸐禱叮嚲槙鞮艜旳盜踶迵玳騳盹鍍娻琱湩黩嘚緿釘錠滌梪嵿苖鳭調鲽待癉缔犜岱霘叼婰締弟冬迨獤曡磓稲轪澸啗读冻裻铤豋鼎鈟鮉蚪觝靯蟷撢眔汈唞瀆哒儋弹昳牘陮簦忊舠靮端桗耽菪鼑簞譵鐜窦奌碷堆叨鬪刟饳迏牃匒玓掸龖瘅瓞笃扂嘟妉眣瓄帝儅吨谛魛軚壂鬬赌撴瀩钿跌踮軃定艔殿淀鶫耼覴第侗黱栋澢焍豄朶的繨甙氐墮遞盗笛迖氘瘨堤睇蠹點钉度鬄垱飣戥逇亣狄媅扚樀汏噸柋掂抵蕫段凋嵟驐黨棟蹈胴蕩蔸刁牴刀胨顚噹鰈聜竇俤腚痘鱽敟婸燉毲髢垛昸躂胆党鈍淂翢窎觛歹狚伅嬁圵丹碉廗毒兌恫働岽橂詄妲朷濎簟掉蟽咑耷傣獨遰碭龘瑖厾鬦店多锝达媏裆带敵蝃刐弔堕镝沓苵靼釣諜檤嶞耊鵰絰吊玷詚阽瑇馾犊喥倲椴擣帒璫懟籪対斗呾嶳档靪杜叇凙臷玎渎埵荻噵単剫衜陦伔递阺笪鎉挡柮坘呧韣顛蠧靆斷媂趓垜殰匵睹隊嶋嬻嚉惮幉埞脰呆嗒哋铥駳叠鴠屌戴櫝铛頧棣艓畳跺倒艡氭缍鶇矴抌瀻嶝黮襶癚鍺藡裰彫咚瓙鬥聸釱窵磸傎埊绖墱東墬蹢涜硐峒镀澹厧鵽蜳鲷迭祶畓短腖丟椣琽楯帎答迪掇娣鎝勯泹憚玬鍛鈬订埬涷囤徳軩镦道督奵薡椯糴魡捣攧剢奲邓隥槇稻蜨搗芏惇諦鋌胅飿鸫蒂阧螮掋覩銩动蒧丢凳藋竳戙擋剟沌垈甔氹壔滇耋霴独匰蓞侢禂谍夛朵腣齻眱敪珶褋煅槝瓽潒墯癜奪但顿蚮敌浢杕遯鮵垫蔋艠驔當吺髧惵衟鍀隝铞柢壋偙锭奠典雿焘動枓凟窞陏頕犢凼亸嘾苳祋韃砘斣觌覿灙登摕蹎憝悼剁奃帄诋担篤骀鯳蜔帯亶疊大疔諌簹隯梑鐙緞譡皾篴拞墶荅陡豴餖碫瘩跶嶹疸璗盾帶痥嫡綐垖敁殆磴揲惰巓挏賭乧碠逮銱釖颠抖毈恎鼕疂墑韥閗韇膽牍钝脦肚悳磾蹲嵮钓渧嗲饏萣襠軧氎燾翿薘厎刴菧箪鼦弴紿荳董殚蹾崬蹬笚丁癫饾崠綞珰灯代挅躖婝哆竨奝疉跢炖贷霮堞電嬞当趤蝊毭橷攩咄櫈黛巔纛達闍等","e":"娿粫樲恶讍袻邇饿琧陑噩愕堮鈋薾毦洱兒魤遏歞軶搹妿俄睋譌頟擜峩锇蚅枙磀阏姶頋蝁額腭匎迗児噁鸸齃珴尒隭鈪鹗鱷遌耳痾恩垩魥鹅咡珥谔鶚髵趰诶僫皒貳锷鉺刵摁煾餓屙二鮞蒽涐爾讹蕚弍栮軛侕覨櫮鰐衈迩尓佴洏偔尔詻弐轜硆鳄餌轭鑩歺戹胹苊悪饵誀阸峏廅閼頞堊莪铒貮齶贰峎吪荋崿駬岋厄諤阨栭娥咢砈騀蛾搤颚訛儿婀惡礘鵈餩而誐扼聏鄂额妸呝鴯鲕鵝囮奀誒豟萼鵞砨唲顎峉湂呃砐屵鍔遻輀峨鰪卾咹","f":"婓蕟艴阝啡軓餴费萉琺墦飛蜉氟副抚栿梤胕誹緋笲韍緮笩瘋鲱岪富蝜繁繙轒吠傠复鴀靊疯牥笵鳧嬏陫父棻帉髴羳鳳轓殕嬎橎籓霏茷髣昲吩发笰趽赋飜糞裶豐梵媍髮垘鼖覅鲋偑鉜负豶畉跗翻鵩匥朆傅覄裦尃韨芙贩羒撫珐払簠饭颿鐼府艂輹付鐨虙頫杋橃滼氛盕朌馚覂鍅怫藩屝罦腹駙懯檒伐郙弣淓秿汾伕桻帆綍岎妃栰风礬鰟翡幅舤釡鋒黺靟辐飌辅锋渄驸封燓椱廢扶浲斐哹妢堏蟦訃沷倣赴鈇枋剕魵柎绋瀿菔烽糐畐绂費旉諨竕籵蠜姂砆肦盙方匐偩僨荴鱕咐濆彿舧茀碸餥稪焚玸奮讽幡蕜紼鉘癁弗紨踾憤縫鶝撨褔豮仹麬瀪榧凤祔祓愤蠭砩冹隫防妚馥炃輔羵婏範敷番酜附捀峊呋漨覆坿崶俌蚨镄法訪鼢焨襥襆黂鼣賵詂阜翂缚缻甶麸霻甫凮鮒桴返矾芣冯鴌垺紱禣麷鳆榑蜂粪孚舫氾箙輻夆飞鳯湗仏乶蛗鮄份沸彂蒶郛発訜憣洑蜚绯凬瀵馡膹鄷溄沨罸騛鍢蚹旛匪複饙眆瓬狒蝮奜巿鯡肤鍑肥鬴蹯盽俛雬访凫發蕡讣暃唪坟否咈鳺浮葍凢鮲賻扉柫纺飝砝菲紡缝樊符袝蚡篚猆汎肪棴妨琈娐廃膰葑閥俷櫠賦匚甮薠凣姇豧翇負福陚躮蕧蘩飯橨汸麩雰仮赗犎妦勫坊妇蚠鰒鐢秎埅苻斧偾枫奉罚渢蚄旊酆癈痡醱曊缶拊絥邞腑蜰疿反邡鶭分茯弅楓胐袱璠钒鲂犯堸綒废蘴蚥坲颫奋復缹烦粉赙諷衯畈拂縛淝煈騑鏠濷髪焤錺寷乀瞂荂灃枎朏柉峰藅杮緐紑摓刜棥猦腓黼肺鱝蕃訉玞鴋沣垡旙房靅烰鄜范鴔钫忿纷泭燔鲼梻軬紛魴佱鷭艀墳服诽俸妋俯捬嬔鐇棐夫粰伏馩噃逢馮澓婦衭悱疺琒放冨嘸浌昐筟乏罰襎滏飰綘婔鎽阀鈁鈖仿販椨灋幩芾趺奿瞓砜幞僼膚棼腐非佛莩風俘鳬昉蜅筏厞峯竎芬泛丰萯麱煩孵枌蝠怤酚黻罘涪胇芳篈凡稃燌炥釩凨痱忛釜昘","g":"滜轨橭豥陔忓鏆榦晐藁鷎绀啯根咕漑叧嫢馃欟拱崮骼魐啒羮筦蛒锆挌煹骾悺稿港瓘賌纥縆光棡邽觥痼猓鹒冎各枸槓龏共肝轱埂湀刿掆汞柺橄摑犷閤璭杛柑昋妫遦臩牿刽幗嫴闺炔峼搄凲膕漧晷幊漍叏閨聒枴篐匌鳏羖帼夠革軲诟縞够崓阣溝辜糓敋腘葢遘毌孤烡勂餶凎功釭箛坩戈嘏矼倌詌鲧鴐盥瓜誥筶戤褠舸朹鎠踻鴿輁浭槻脵稁尲厬癏珖溉贑呷緪窤垙匱劀韚汵觏蚣愩挂莞蠱撗龔圭螝堈檺耿賅槼蛌圪钙罡蟈刯關膏錷桿尳亀俇嗰炗倝槩乢髙缑泴彀硅姤掛袧呄岗肛僱归鈎轂歄撀戆詭棍規觡故鱞绠更祴桂广暅輄吿鱹觵钩痯瓂桧鮌莄赶緱貢癸愅耈勾姑剮炚丨囻蓕擱匭诡珙鳤皷謌鼔祰檜旮戓梏贯鸛鋼媾鯁股鳱櫜椩古蓇矸姽豿鉤瞡龟罆疘苟髸蛫牫瘑羙槅輠茥錮垢过訽彍犅悹割嘓宮炛尬絯忋拐棝貫綶墎韟侅工桄椁芶佮劊鑵綱侊蓋賡栱觀鼛絓衦恠杚规诖肱槔過熕鞲檊睾噶个菰穀弓祪爟罁堝嘎閣稾冈榖珪趕臯胍鸹膈给臦闗庪菓告牨钴胱贵袼峐鲑猤鰔哽玍罣淈迀龚蛊呱縎罐苽锢獦歌改揯厷咁盬槁鮭鮕謴栝惃牱馘罫鱥摜稒慣皯纲贡詁鯀簂棺鈲蔮庋櫷郂嶡盖廾焿杲騔雊硌藳畡逛皼嗝掴禬崞紺彉黆怪坬姟尴冠镐槪蒄巩灌酤剛鎶亙嬀匑櫊镉絚诰梗颳扢魀皈鮯戨缟焹顾攱彁馉睔牯赅趏蚼蝈僙概鳡篝榾簼摡荄伽濲袞韐郠窐錧咼氿鹄赓构钢匄艮沽糼狗媯館哥襘垓菒褁礶冓灨乖犵蜾鴣汩格鬼哏惈羾敢給挭筀耕馆睴搿姯鞈詬匦臌郜关覯裹柜玕估虢塥泒躬诂劌隔果輵滚丱蟡剐滒茖岡糕囯躀骨鷱餜緺仠耇尷簳顧雚鶻啩茪羔滾囶疙菇龜咯佹圀杠錁辊毂鞼胳乹舘畊甘覌聝尜絠幹蓘廣观鴚堽灮淦骭高瞶笱璝杆官椢仡鱤粓耉跟胿鹳観該禞櫃瑰躳椝铬輨玽管觤缸槀槹攰埚粿瓌拲芉巂臵筻谷傀歸膭固皋公鶊雇袿獷塨苷槶広銧颪唝郭箇緄隑潅罟陒夬鱖鬹堌扞軱箉鴰亘佝刚祮坸冮橰褂轕唃菮该丐傦薣盰寡碽贛夰撌峺秆鈛绲鬲銽愲庚搁鞏貴崗笟涫亁肐蔉柧锅購皐垝鈣鯝竿岣滆阁鍋呙裓瀔觚瘝詿鸪鬶祻赣感鰥擀沟钆堩輥焵鳜磙軌搆恭郌戅尕鸽構笴騧掼鲠恑帰购蛄供逧国濄鋯攻卦洸哿宄衮澉廆干罓匃罛泔癐疳騩琯匔煱鞷餻國篙箍簋嘠淉稈虼鼓摫諽茩綆祼葛惯筸夃凅尶搞跪慖旰宫攼鐹鲴酐唂鈷茛矔咣暠韝鹘瞽個羹槨樌慐刮関","h":"汇檅胻懽缳鰴搳埖詪火谽洪彗闂鬟紭曶傼秳蚢猾華喚哼户煌曂熯喖壷谹隺蛿糊夻和桁洹宦廻荒苸菡棔矐缓贿鲩鍠褘椛闤筕婳顪何紇圜頜鲄梡簧鶮涽廽烀嗥訌吰郇浤垀鴻祜鉌觨蟪抇櫘堚摦缋晗哬恚壺鐶鄠歓葷龢瓛鞎焓鸌換鹖昈濠啈翯杭螖姮凰狟曍葟斻鬨鷨狠畫秏娢話嫿麧撶锾荷瓠瑍轟阛篊猴红豃狐鍰梙篁銲鶴皡鸖汻姀阂豁頦會劐訶焢貉患箶睧豪譓溷惛焝皞烣慌昏儶囫蜭豞睳癨鈥噅魧滙鈜竤猢騞噑炾簄餱鮜隍涣嫨姡翽鍧寒靍貥唿彙嚛貨詥匢镮垾夯悍籇澒哕衁匫蟥訇楻誨琥瀈撼楇嗃噕嚿嘩鹕哄愰綄嵅戽瑝螜閈攌吼褱煳荭晎铪燺軣瓳奛徽晘愌椃葓餀饸兤恍還龁槴絵嘑嘒蠔禍焃紅仜儫圅环耾粐诲烸桦蜖拫崋豢磺馠嘷烩煂藿诨郈翰磆喙魺堼翃芲鴴崲暳或虹耲忽爀墴琿虷穢澮蚝讙獆蒿鶡鯸竑楜亥悎矦貛粭焕諕怙坏蠚号湟嵈佄暵浫淴剨頮逭蔰絗迴敆馄皜后澅碋壕哻熆覈锽薃海蘾嫭魱郝毀嚯萈脝恵揈瀤货韄蝴乕賀頇檓猂骸洉谎悙诃嚖佷滬袔騜蒊謊驊瘣煇浛畵皓灏熩黉获唅菏貆吽觳櫰扈逥秮卉葒汗黄皔滹鶦钬滑化塃辉颢槥軤含暉鮰俒鱑癋晖睺翮後鵠憓涸氦护痪盇綔弧嗨蔛孩痐戯颃熿鑉籺浒蛤恆譹沎昦綋赫嗀毼詼寏回肒岵歡翙濊奤懳纮苀鯇葫閽蛕黒彚帿胲頏茴劃薧環鍸垬渹轘邯灰螛藱楁璯贺璜弖瀖奂繉耯崡佸澔郃荤旤繢撝繣唤諱頶垳芐活垎渙掍橞婎凾舙喉薨詯嚾麾灝玜鶘咊號犼柇鶾翝蚵闬泓鵆臛渱啝迒函靎闀呵嘝喛怀魽巟癀硔狢謞珲鏵嚎鱟核鍙肓鬫韩奯圚賄閧駭堠阍蘫翚璤瑚湱餭縠厚鰉槵魟硡曷合紘恒骇囘禾戶咶婲轰譮諻曤篌砉瞺俰熇轷捍暤荟夥好恨虎昬輅鸿乯靃褢駴鴅羦汉寭杹浣逅霟鑊酄潶叿晦淏芔燴轋罕鳸鋘堭垕鹮花胡毫笏蕙瀫譿諣皇撖鹤鵍頷渮鯶徊偟瘓鍃嚄鷬漶呼抲鍭兯踝徻峘遑懷睆盉艎顸鲘互憾河弘糀恗雈蘤澣塰糇獲鋡话惶嬛换擐掝拻鳇錵淮鎤絎矆劾梒鯱恛翵霐蚶峆爳閄皩怘煥嫮樺婚奐岾萂澋韓呍譭霍幠薅皬悔娂齕黊洃寣阓釪嬅祸摢烠筨恢媩膴詤荁秽喝薈涵珩汯滈鰗頀宺阚獔嗊懐肣潓鞃暭繪哈鉷僡銾怳揮洄瘊痕蛔鬍伙衚謋屽哠谼鐄饚櫎欱沍妎耠譀闳绗睯昊斛黌檴很帍俿毇鼾华玒喊嬒镬黃谾晃佫趪噷鎬隓貈讧忶輷醢哗萑恏潂葔丆朚戸薉浍阖横餯枑鰝濩顄鋎閎鄗颌豲蝗吙翬齁滉壞憨诙幑侯鼲雗慧壊篲瀚沪涆寉嘿妅毁澴鑅虖蘳幻穫縨咟邗湏潢鏸冴画烘浩萀嗐嗬乎獩釫輝醐糫媈搰譁歑虺虝鋐倱绘鱯楎宖酣殙还屶蜬靏魂盍餬琀捇螒獚蚘驩蔧浑粠咍佪毜訸茠餛沆緩匯盒竓犿囬壶繯鲎颔駻雽桓孈槐酼澏屷锪蒦鄇甝徨楛虍耗豗艧傐会褐秴湖嚝鉮歛邩晄闠烆惒旱骺挥靧殨嚡禈橫鐬硴篕焀欢絙涥彋蠖澕颒護划槬壑晧蕐韹槲釬咴熀唬蘹蔊亨攉謼誮黑昒笐蕻榥喤焊鹱錿混害諢眓礉雐燬馯烉闔衡聕鳠鸻慁撔隳漢灳讳觟獾翭睅惚候冱鰀宏蘅袆鞨灴厈泋圂嚆寰皥惠穔獋皝漷藧莟幌媓諙航惑泘铧婟渾骅顥滸苰","j":"踋屩絸弿匷诀濈醬禨琚痉旍銞稩淨鲪苴輂躤靜記镜埍嚼屦罝叽歼砎江釿菊紒耭忣齏竫玾侭搅井婅惧筓蔇睛誋尖坓决麖莒肌津譑蓟畿妗厪柩摪揀哜麔凥奖掘亽犍曁倦蘮撅頰茭燼袀堅挸洁街計糨煎隮迼檕餃崛幏抉笺鑬毑佶跽瀞嚌畕礛溅鬋嫁鵋韁菫皦憍呟鮫蠽倢糾菤鷢瓹级竣鵑狷賎疆拒泦踐積挙蝔銁践鲚及鼰矡鱾丼襟卽鉸詰競暕剣漃崨乆譎虡薊珏濬雞蠞娟伋蕨浇蛟焗奆烄獎鞬皹较襋鑒璬亼楶瀱瞷鐫斍艱毩蒹譾葏驥假椈跼唧滘椷趼狊景櫤饉焦矩夾旔飓醵讲茍阶寂埉稭寖蟜覺矯怇彶見摎恔剄洊芨鶏擧捃袶臄賷晈煍旣梘葌加堺橘椄玨珺鞯贐嬧閰綗胫絕奸劗捁荩觙岌肩蘔猳剂蔨嵥螿旡鴡凈濟睷鋸穧繋妓鄄祲豭鵊炅浃牋獗颉覠畺镓娇经摷糋耩劒鏩惎麇螏将砠鶛检爴畯栫窌槿脧坰虀牞泂葪珈劑罽瘕撟蕳泃蚗燞槚觊簊基蜠倃檋桱艦鱀迳経昛鏶饺臮鯨楬忦琎朻价螀奨燋嗟挢际迥夹咎姖掲姢跻劋轎碊嵴鍳斠瀐攪極饥乬奬艥殣姐鲸霁鞿梜鍓监巻舊鰹燇櫅鬮嵆踞觉偼聚砛橛皀潗金寄嬌蝍瑊茳僅疌勌粷骄雋沮顜劫觖獥枅憼螹几鱭麉胶寋狙踺駒勬貜扃渐礍縑弡殧埧鋏斤劍齑篯岠雦极钅浹湨即姜擑玑崌驕樛蜐鞊笅鵴筧幯节茧巨缄勣鐻漿颈巈竧儁见铗姬举癪裚骥鑑纠绢鵤泇趹齌躋漸嘦皍枃賫釼鑳覸済叫籍棞覬接藆挶嶜嶠鎅徦搢捲葁鹣犗傕檢莭娵婧伎玠戩趉傹皲機徣橜鳒轇丮檝汲榉蕺繳鳽橺继褧颊僬孂奺藎鞫鍕媎癠湒京刏屆暨墹倨齨廐坙己蕝醮階靳昅兓积俭稘講駉謯誡龃鵳埛袈鄿喞戞驾紧岬劤絶疚夅吤縉九桷堻焆勪鐱计箘楗戒鏡鷲謽梞頬蚐觐菺缰缙獧裐緝錈穖鷑韮孑家瑨檞挤掶鹫蠲郡櫭嘉件叝臶腳乩薦煯潔剧浚涺疥桨玃犌钜腱建郟袷噱谫荐锯疾駶將貑卷芥膠窶聙熦蹇穚蘜鋻旧鲣界決堦僭玦級譏淗喼軍讦髻架僦巾囝饑痵嬓镌羯菌監覊爝腈燝猄揪頚蘎噍陱裥鳉峧剑榗偮覵恝穽慻簡梷鴂跤鐝豜缉怐詎逈侥阄刔剤丩泬鰶湬瀽侰交劵輯讥稉斚弪麂解汫碣蹐炯郏椾節睠冂礓懅久鎸禁馑竟驚炬痙堇距烬彏皭愱诘狤鈌玪袸躹蠒縳鬏曔蛱濺徼莖淃喈踖减彅擠贱蟼壉雧訆櫼璄婕厩尐缣偈椇璶焌剿徛君隦澆鴃揭徑瑴頸虠绛撠扴駕弜嫤豇疖濜猏帣經鹼脻砄絹鐧潐鐗簴衿踽蟭珒屫吉荆椵覐藉枧靓鵙进觼臼笈壃锔蛺截繭歫進犑耤桝轞褯妌毄曒菁剞襇趝嚍坚詃圾洎皎覉鎵刭赍虳跙激瀸継桊婮茄鱎嶣戬飢晙駿瞼秸箭勼鵁倞艽茮襉鰿湕鮶磯匶精拣仅氒際噘乫颶荚鞠鲛攟袺懼傢澽傑麠駫撧鷦怚麚噤窭廭惤峜欮躆榢跔礀謹灚遽迦壗幵巀鶋埐矝岊塈镹嶥徤蹫紟齽跡罥釒熸殛腵秔敎桀柬憿謭俊急鶺緘角楫翦鮚灍抸肼咀皆锦弳嫉巠劼憬燛捄婽峻妀菅墼饯犄轚裌廑駏卙铰冋鉿魝涧憰匊唫兼抅鱂鹻钁塉欅揃境间巹贾嘰誩鹪赽彐樫橿藠唊滐蒋巪蕀毽魪煛记敧煚鷄绩悈兾豦鉣侷忌匛嘂烱拘檟璡泲径汮惍晋諓濅舰錦姞璥榘鹃桾狡鹡救龣盡纪疅糡霽箋菹堿钾俴麕刦魕徺鮈爵勁警戢粔驧茤拁鲫斝劔毠韉鈞諅屐朞諫璟琻邭鋦暞秬釂荊槉姦睑赳澃齟鰎鉀粳蠘颎煡靖集枷鯚耞襷浕虃耟訣鼳军礁殌賋寠幜拮桕轿击犟啾箕僪宑鍵筥谏婛萛譥匓句鉴踕趄佼睊撿糘溍膌裾鑇僟磵懏卺繮舏滰芵健硷擮羂嵇杦冿丯睫媫蘻蓵僸教衸劲笕脛虮济擶鵘矜俓薺晉賮诫繼嶕躩亟洚鉫韲減黅涇既魢鬾囧廄究觭剪菨紀劂椐桔笄圿礆锏嶻赆矶苣櫸姧蔪楐蔣姰敬謇呌郆賤掬鵔痀檵竸埈葥舅鸠韭爠銈牮伒迹欍鞂勥踁亅倹稽居冣絭眷窘焏呁捐餋浄葭殭鵕銡繝脊鼱鵛静戟季冀稼艰谻痂熲缴屨勮賈椒撹摾觔焳搛谲間揂兢瘚樭儉陖蹟笳湫鶪介嗘貗荠刧琾蹶挍痎叚趌辑讵箿孓均幾戄拠澗愳獍雎鐍梮醤近餄橸穊臫躸甲結捷疽囏翞憠櫵郊揤鹶錤膙敫舉戋刼艍驹餞揫趜彊蘏酵箟鶌嶯歏攈蟨咭畸漌鑯跏机棘糺庴暩榎譼碱鴶矍旌擊訐鶁卩鸡僵凚傋浸具絳俱諊暻璣锩借窖剱價譤鮔絅姫戛稷襺湝谨蓳槣儆墐酱届鳮鳩嘄脚晶豣捡蕉齎芰涓酒孒鶄佳掎蟣柾袓嫅攫畍寯僥鶼蛶庎弶撃悸技劎敽劇逫莢匠跲羇鐎爑鷮餰蒺膲祭浻餕骏杰検殱匞麏鍻亰丌蒟儌馂蚷郹鲒羈玖倔倶鑙泾烥冏啹浆籛較筋芁皸絜靚禝蟩橶霵卪就矫汬毱今犱觧覲據纐蹻趭穄羁槳瑐乣灸覚績绝鹸飷鸄垍韀敿飬弆尽榤犋刉噭碅茎簥殲欔降结儘戔瑾慦隽媘衱疦局骱婙洰湔蜛懻緊蚧熞珓癤鉅捔踘襀薑竭僒挗絞键杢臇岕莙紤漈瀳荕惊逕誱坖駃聥钧彑胛瘠竞姣漖阱廏汣蕑珔嵹坕鯦简眗净馢蹷腒镢鐖峤绞厥据埾","k":"锞钶粇恺鋛鯤鱇嵦揆萪忼炣塏欵瞰空栞纊銵銙髋鹍愦篑歁擖獪硁婫侉蕢犒客舿鮳垲抠壙硱嵙桍軦況喀绔錓榼扩恳課霩劶坷宽拷廓巜劻祵騤开墤咳穬眖贶牁矻槛可脍翗炕磡诓媿瞆庫酷卝苛狯龈墾軖焜蛞寇刻揩崑擴抂殼块戡貺闿儣磕墈鐦颽邝錕圹骒诳裤漮絖廤煃釦囥摳顆邼筷奎恇鲓愧蒈嵻坎窟焅垰秙剾梱臗衎愷絋逵巙熴髠匩懭阃慷爌胯偘髛愒洭苦矿枯欿菎夔郀哐蔻狅尻埪筐餽鲲瞌褲掯鄈忾潰戣堀匼况銬軻閸阔佧瑻剀刲圦课跬靠裃髡框堁韕狂骻愙鵼齦顑葵樻瞘渇胩狜刳肯聵昿尯聩窥蒉竷憒硄纩鵟坤抗栲咵寬裉醘砍盔礚埳嬇壼蜫勓蹞櫆鍇瘔崐懖蝰啃妔楏鱠鏮嘳綑蒯壳涳頯欬硿馗奒凷聧劥挳碦矙硜扝饋欳哙裍岢哭肎硻岿筘渴懇猑腃匡窺缂括溃絝槺髖轗伉鎎噲聭髨筈姱蘷樖刊穅口薖擓謉冚鉱闶窠溘鈧褌铿晆閌眶勀艐旷崆喹髺凯鎧錹锟颏萿凱郐鑛鄺龛躨膾錒鍞锎簆犪闊肻恐嶱鵾廥愾敤闚躿軠慨堒睏趶俈惂骙柯敂犺彄鍷鍨闓倥卡鉲邟困鈳鞹款垮顝壸開尡箜暟嵑騍铠頢控咔鯌糩睽跍檻烗輡魁阬昆吭鐀摼籄宼悃旝筺炌攷袴鑎挄蘬裩鏗剴夼揢裈咖匟誆犐侩窾鄶礦輆叩眍牼胢矌考騉貇軭悝楑糠懬藈誇窽坑锴寛儈炏琨匮稇鞟嘅塪頍氪誑窛龕烤冦夸尅砢桰鑧躻垦鶤喟稛虧豤礊轲嚳濶歀堃鞚滱瞉砿挎亏黋岲砊莰颗閫骷稞緙鷇孔芤摃勘勊鮬搕葀棵虁忹蝌快扛晜隗曠珂褃恪科铐侃闞崁涃扣克崫娔嫝康圐醌暌塊库丂跨鬠誙鲙簣疴看嗑堪髁趷馈潉衉嵁頄蔲钪悾亢剋捆洘喾巋拡楷","l":"玏棆栏艛篥蝲駠壘攣裸醁癩鬑礧矋浪撂霳簕磷晽輪燐囵氀颅栛綹鏍檁蹗燯朸麳陸倈懶蜧熮灓峛斂麐缡鐪蔞磏蒌禄侣荔孌琉茢瑠逯谰襱鈩囇潾旯濿耧鴷烮譋硓褴炉睖氯詅乐欐栃葎躝驴塄驢蒞锂稑藘莲瀘萰詈鑗暽凌爈奁郦嵐洜賃鑥棂蠊论鎏栌欒嶙僯陆奱憀瀝拉膔搮迾樂眬鹷娌雷良轮匲顲離榄癗臘厲萝铑窿盝唠屡豊檪轔圇犖簍膦吝潞俚擽犂霊羉涙囹鹂鄻錅峢蔹輬跉纙掕洌鬛蘭藶蛠磮軨冧鬎朥浨躏栳歴瓅龗裲鏤鲮籠搂綾劳鲡理隣鑞鎦鸾攋橯暸籟斏厘廔爄爤抡踜鹭窂纍倰坽躘櫚瞜劉稤慺郲菕虜林靋泪椋憭耬烂珋皪螻逨廖肋釕淕韊竻峍唎稜闌澑籢巤轳酪唻麟鏴縷驡樑乱鋝悧遛誏甊瓐橉瓴魯龍络齢蠃墚蜊轤倮涖翎縭鱗鬁闾落俍鱸捛崙豅賂鲤仑鏕梿閵連昤竛嫪庲六擂玲娳哴历栎慮鸓懍儖灆曥叻鯏篭腡耮剺簵蠦赂癛蹽磂埨览潦躪哩藰鶆覶鉚捋褛羀駖龙襤掠閬钌粼镠椤飂啦篢騋绿蔍鑢珕鐒籮揽爦浰凜翷攬蠟藾鰱莨陯攭臈崂賚橑嘮魲靂疬媹崘率魎樆熝籨箖脼粝莉饹纞頪覽敹拎涟欞鰊鯪湰鸝轢艪芦砅煉罍蘺嚦菱晾蟉嫽徕臉砬鋃僆露鋢撈礌耒隶漻镏掳繚孷蓠了猎蘆痨嵺聫咧蝼勞戀攎欄罹烙雡藞揦蛉辢綟裬瓃鬣寥膫鈴壈躐伶犣鴒亷嬚聯櫳朗垒僚廇爐鹠磱鏻硵浖蓾啷杝禲玈鋫郒漯卵攊領鏐禷療髝罏獵癘骆淪燎搚埒靇蘢立劣徠旈蘝儢鋁霛厱稆痢攔岦藔姥婁葻攂碖漉滷鑪藍閝瀲鯠擥絽录澰滥黎亃顱畧酹镥銇滤雒琜涞籚揧擼羸鯉珑蜦糲竂沦癞崀孿紷聋蹓劙灕槞啢筤耂蘱礪櫣飀蜽浏嚟鱩嗠朧凓卢耢醨屪陋利蘞濫笼鍊阑瘰铼靈椂溜砺鮥蝷覝虑娈巒廪筙菞栾镽辚睩啉菉襽鵦籃琭慄櫨龒彾澛凛燣鐮牢澪类鵣隆俫璢磊溧寽另垄艣咾蚸縺朎谅练篮挘榴挛憦淶曨憐廊挔坴坜唥厽嬾铝孋儱辂練膋侓踛琌邏襰粮礨篱潋爛峦獹摝碌謱裢鳢旒燫箻膢醪釠蜡瘌輘棱流萊橹瞭磥蹥疄裣瓏槤飅笿蔆嚨痳腊憥掄璼臝锍虊令喨留鴼鸗岚蓢塷儮籬畱錀艫阞蘽襝錂栵绫擄嵝鎘鏀鸬茘硫贚溂羚蕯睙聮廘賴僇咙摟錸恡嫠陇鷺漊薐岭鐂拦锒鰳磖例騼錴軂緉殮驪鷚蔾蒥楼梁赲勵欖偻炼镂鷅溇荦圝联瀶鉝朖冷簩琳雳逦忇箂瘻泷爏藟輛翴嘍囉繿镴崊鯥罱礲栊祾奩懰楋量碄罗哰騮纑骝廬嚕亂滝輌蹘鋰纶慩鱱励櫔鯬陵兩辘铹梠鉻鸰镣栁镭藺麜蟍臨螰頛厤鱳澧绺颲溓郞纝羅軁両胧罖漏躙綠衑浬苙逻婯镙镰蛯窷昽赉髎蛎橊璷鬸炩蔂嚧瑯謰浶瀬疁塶烺擸錄綡斄旅嫏嚠儽泠硉疠剅韷秢淚蟧倆灠遼黧嫾疗俐斴邋漋侖蝋楞鑼俩砳佬嶚瘤猍竰嘞籁脔涼魿怜卤鮤峲鴗礼孄駱瑬緑轑踚脟懢砻龄廫隷櫓橮吕丽泸稂檩俪鹵圞喇淋穋糧瓎崃崚蓅哢壣劆僂苓鞡嶗癆孏列臁鑨麓邐悷漓泺琍埓辣轥穞靁侶沠嗹茏柳霝囖藜醽蔺覙簾戾趔桞繂裂尥颣梸櫐舮莅矑垃觻獠厸鼺尦聗蠪來饠跞虂麢轹麗鑘攞蠇粦騄畄嬼儸鳓尞辌湸煷箩樃摙嫘膐驑鑭蓝蘲攦囒栗讕婈巄腀鳞霗濂覼纚锊蓏霤廉縲瘘孪鏈鳨鏧夌阆鄰艻呤氻鞻臚鮱隴梇癃敛欚馏礫毟皊氇镧蹸璘寮燗濓瑮嚹簏艃蠡蟟峈鱲礱壠塛琏犡齡淥濑撛兰霖傫爎孁惏謧勠稐轣屢烈粱箓髗懒朤鐐圥遱磿炓壚凉璙鹩莱沥崍荲鷜攏漣瀂櫟蠣媡壨蠝勆繗来粴剓絫躴胪巁圙曪爒梨灵躼瀏喱屸狼竜櫑鲁籣睝裗匳論鋶蒗顂缕觮馿嶐燷欴鷯灅睐銮鵅翏禮桺磠伦璉恅棃泐蓼臠鰡釐辆娄櫴撸櫪驘珯蕾猡餾瀧枥瓓顟冽郎缧篓騾婨两労閭澟驎磟柃簬澇鎌儠瀾菻蒚蠫啰螊蘦虆裡湅阾垏庐硦嶺鸁帘瓈缭嵧籙澜瀮袊哷力彔幱榔鶹嵂堜岺楽扐儡刢掚鱺锣剹离龓錬狸濾熘垅纇傈鸞蛚涝聨厯虏蕗癧姈璃淩濼舻棙瓑脷飉滦老氌焒簶鵉舲巃劽盠讈钄髏娽醴缆爉楝勒塯嚂斕穭魉壢脶蠬塁惀璐略鹨梾鎯癳喽剌遴鑾堎蓈曢鯻廲耣吏徿狫脸戮裏隸婡笭櫖屴鱧爧焛豂盭苈鵹檑樏亮諒蕶撩噜盧翋塱曫賿铃洛埌銠襕路珞絡洡砱踉糷呖歷飗膟膂蓤骊僗临畾邻鑸拢螂蕌癝辽聆鹿螺郘礷悡摞粒煭駵灡领刕链竉罶癅樓褸餎婪瞵暦蓮厉壟硠琅筣溣鍄頱誄赖律趢躶愣祣玀欏呂捩悢睞菈嶁猟倫褳蟸類醂鄝礰躒柆糎料懔姴轠勴麍勎骡诔囄頼捞覧曆狑挒録髅唳笠秝嶛鸕儷桹垆棶爁屚熑履瑓李邌蜋塿蘿累剆赁櫺唡悋轆癴瀨爖嘹碐鵱灤梩里鐳樚酈鏫聊黸綸襴鲈甐恋腂粩矓仂连艆沴薕鯩瘺簝刘鲢粶渌謢瓥榈犁貍零噒甪漤欙斓褵聾曞纜駺獜殓讄穲砾祿酃畂枦錑猁樐廩荖羷欗熡癵","m":"藐镁嵄売犘黽杧魔塻満庬咩貿們满曼墨冖竗嫼笀枆寞佲酶腜旼覭灭髳抹瑪堳菛敏鷌圽姳眠霡燜渼慔魅摸沵淼洠帽硭鈱镆鳗娩禰喵鯍冐甿嬷凩夣戼螟矊镘谬毪歾每懵沔牟旄鉬某塺獌冒篃蜜哤蔤汒麪螞糢棉橅蠓蚂唛糸鄳钼鴓猸緡茆捪朙蔄瞑鬘纆勔牧鋂廟墓嘧冪缅摱祕鰢蘪碼免缦嘜丏毛鷶蔴鱙杗掵燘秣买刡闽懑妺幙榓閁銤瞙泌縸榠牦珻米芇賣冇冡閩哶嗎玫瓾嬍搣暝泯秒楘蘉妹娒茗絈佅弭葞徾蟆莓櫗羋默酕緢渳鍪駡櫋邁虻蚞醾码礣蟎鑖谟嚒毣悶峔麰粖黙眜谜杣冃摹茉偭鋩蕒吂樠媔沕禡呣盟膜縵霥覛韎塓儚礞旻衊幔踎絻蛖饛馒糆麥名蟇屘汨缪眇曚宻旀獏祙篾愐蝱滅盲镾帓饝釄驀宀募麊茻鄍謾懱珉緜煝糜冥麵鸣戂岷狇恈玧罠鱴镅砞睂没眫鍲蔝懣脢模敃鞪亇勐痲靡湄亩鸏墲瘼郿贸鄚杪睸幂瀎麻鉾腼鄮粎母霉鍆艋忙虋渑鞔楳劺矛鏌鎷木眛楙昧門眄葂爢瓕黾笷熐泖蛨昴牤錨卯枚嘪槾凕脒暯乮髍羃嫲湎苺抿禖仫鶜潣僈鹛坆鰻呅脉歿漞擵侎灖靀铭脈殁茅麼矈妈鳴埋茂傌鮸睰霿鹲犛墁蕄毎眯獼駹萺濗砪夢耄黴藌迷茫釯蝥沒瞞吗蠻閅矏密峚磨簚陌懜謐攠繆銆门慜甍怋祃嫫皌霂魩锚猛汅軞雮瞒澠熳睦掹鬽哞蠛邈鬕蟊鼆媌浝蓦葿苗溟苠琘憫荬谩氋暋耱蓩鉧缈暮畒艨蘰貃蒙罞尛牡痻目莽緬末擝麋魹溕庿毷畝瞢昩梅锰扪貓璊檰帞鄸饃慲嫹爅緍鳘嚤踇幪胟蝐鏋醿芼敉闵黣顢錉銘钔邙滿挴麺吀靣鸍蛑躾謀溤鎂夘麛玅鼏缗眊礳馍么鰵愍覒愗眳勱鴖硥獁冕笢庙猕覔命袮蟔攗勄謨嫚慕渺民詸橗砇莯牻霾饅錳貊莾冺瞴渵湣懡颟蝞跊幭湐媄惽瀰麿蘼痗萌狵坶濛矕祢濔猫洣崏謬眉煤僶氓檬琝暓捫畆湈麫幎蛮顭枺姏杩酩乜劰喕鶓髦樒罵嘛婂孟謩鬗碈鶥幕覕梦縻滵骂櫁媢焖蜢浼蟒緲袂擟媒蝒莫簢孭鯭楣漫穆蠠薎麦覓袤買蔓蓂瞀靺霢拇麽脄懋明觅蒾怽鴾敯濹壾悯彌嫇眿嵋氁穈弥烕艒薶咪淧皿懞瑉椚秘宓寐眸栂堥篎鄤笽貘峁嗼铆芈蘑侔謎嬵慢貌遤美芒醚恾漠孊眀猽马澷嚜们蠎炑詺描冞皃洺苜衇瞄蔑勉矀姆莈藦摩漭卖铓鹋暪妙鎇矇獴莔悗畮媽忞柕绵犸劢媚姄迈臱沫尨娏嚩瑁螨瞐劘垊谧睌闷綿抺谋沐矒媺癦幦鏝馬玛嚰盿嬤孖沬瞇眽慏襔閔瑂朦榪凂痝面槑牳庅氂畞","n":"敜眤姩惗咛挵寗傉撓暔鸋靹觬焾乃讷朒伱梛娞齯褦枏辇撚炄柅煖戁愞內弄钕埿槈農脳霓袅挪宁糥脑驽臡譊年腇蠥譳錗妞麑屔萘腉納靵裊駑奴儞奈攮擬鸟艌嗯蚭纽熋豽躎瑙倪蠰詉怩臑妮隬衲匿橣碯愵醲廼聣穤篞脓秊譨拰佞槷啮鯢臬侽寧薿聶獳淣呶釀捏涊堄糱蔦婻儂鈮伮狞奶揇淰秜巎坭畘聂秾薴鈕澝笯卄紐囔鲵踙躡簐恁侬穠瘧撵拏聻匘醸乸啂湼渿魶暱妳鯘籾拧狔妠钠搙枿黏訥腦癑聹囜娚袦埝挊饢尿挐糑馜黁跈耨迡嫩楠弩嫐郍你逆釢念疓鐞惱努貎婥虐諾氝帇濘甯鑏镊圼羺餒脲拿雫鬞濃傩蘖喃嬝嫋娘苨褭蟯胒脮逽遖鎳诺硸嚀膿吶囡浓稬湳檽碾苶搻蹍捺孻男齈錜貀鲇您鑈齧茑镍憹腝垴衂倷柰汼難抳疟伲蜺儜餪孴儺氖铌肭鮎脌牜掿辗锘檷蕽誽曩喏闑樢鑷夒鈉寕赧蹨橠諵倿泞噥辳拟惄寜农莮嚙悩涅堖鬡苧襛嫟蔫膩嶭鬧那陧孬蛲釹儗纳囊輦糵櫱痆蹃柟嫰耏隉喦嚢胬噛煵柠鲶笝捻軜輗囁衄恼昵獰摨嬢籹縌鮾孼榒摰檂廿聍奻泥内沑钀怒巕繷搦嗫抐酿忸凝恧孥擃腩螚屰疒牛砮閙怓揑煗棿抩郳欁眲齉馕挠铙踗嬲糯钮踂南臲獿馁娜唸寍猱鳥懧拈嬣嬺镎跜闹颞囓鐃耐獶蝻懦菍錼峱籋檸鯰氼哖哪狃女孽嗱嵲擰鎿鎒矃睨渜溺燶萳碙腻讘莥禯侫攆暖孃鵇欜尼旎婗鼐秥迺呐淖儾呢晲能嶩扭哝灢顳嬭难蒳乪艿硇蹑猊","o":"吘漚怄筽瓯膒鏂欧歐謳慪甌讴鴎藕塸沤鷗噢喔櫙腢鸥嘔哦殴耦蕅呕齵偶熰毆藲","p":"鮍匍嫎嘭鞄扑烳颦箳屏螵鎜泙醅鄱韼肨潎庞蓬薸帕艵斾諚狍谝蘕貔圮獛暜裵壀蜱澎鑝蓜埔椖驞荓朇纰逄攀葩雱蹣覫媻礔珀擗厐癖翩怕坯疱埤洴鸊砒諀堋平硑镤飄偏蒱圤韸畔烹瓢紕陪疈幈薲肶顠仳闢礗弸蟛稝賠枇趴屁輣盼纀岥嘙檘溌腁泮魸蘋辔髼剽潽派犏氆馷棚鮃婆蹒溥伂騗瓫毞怌鹏狓撲菐錃喯缥培鵥咆抛飘贫氕胖芘麃朴穦暼蚍聁蟠呠毰敀舥鉕垉駓竼塀媲妑縏嫓邳牌剻朋貧豾桲抷皰諩賆洀囨諞抙瞥砰焩狉刨幋鲏普蒲鴄剖牉媥蚾浦膖铺丕礟浿歕皤豼涄僄簲炇褜蠙毗縹蒪漰瀊椪蒎熢伾掽漂嫳蛢鎃魾磇鋬鵧崥眅榀銔魒掊騯袙楄軿仆玭樸鷿沛啪庖陠慓軯悂楩蹼皏塳彯沗砲撇磞鲆頻怦墣破盤磐嫔蟚蔢嗙排撆淠掱譬阫哌葡阰萍魮謈銢岯淜喷篇竮胚嬪冸岼爮螃瞨琕蚲鑻抨巬麭駍娦翸樥炋骈粕錍圃瓶泼槰稫闝翍騙噼婄拚辟娉帔庀蓱甁霈譜筢鬔衃徬帡慿醗脴炐猅鍂頗憑犤捧淎鈹频品乒蚽羆伓泊岶穙飃廹赔苉琶貵丿腗秠苤匏配嚊龐批勡皮噴閛芃評湃犥膍裴錇潖凭鉟骿曝捊螷殍泡湓輧娝鯆萠昢铍圑碰犃挷郫倗酦鳑簰瀑片舖皫苹澼魄胮脬叛姘笸贌鼙缾尀僕镨硼嚭彷脾璞俖鋪匉鏺霶嶏舽篺麅评凴啤裒蹁拋軳纄鬅沜襻鈈耪判龎皅炰霹箁奅锫濮踫恲覑胓噽袢叵咅砶帊蒰鏷抔搫憵疲坪琵檏礕聠谱郱蠯僻頩莑呸颇揊罴彭轡畨胼姵葐跘攵噗礮髬嘌槃甓酺莆渒牝憉鈚盘旇馪俜膨潑舗秛佩嚬拍屛錋脯迫肧哣骗枰頖巭潘鞶溿棑鶣旁輫篣跑詊汖焷甹匹顰陴洦毘炍爿簈耚醥帲睥鐅聘攴票嫖杷烞旆菩拼呯梈擈駊磻跰钷徱萢鐠篻翲櫇旚爬痞劈釙炮俳篷駢珮乓滂钋鵬盆瞟疋騈袍玶矉厖徘坡柸披砯釽","q":"魼棨绮宭戵蜻鼽婍碁刞蜣豈禽懃猐恘戗棄逑權椌窃諐輤癿潛浗頝汓迁槍摖寈鰽鬈墙霋髂駩撳屺轻庼輕氍祛邔侨鄥盵砌瞏唒请確皘硚寢瑔奍簱匤仟癯釮漀斳洽梫裙綦蟝曲墻俅戚鲭龝蛣凊玘訖嘁釚肵啟笡踑缱敺颀浅羟咠芹煢鞩启嶇啳騚蘄礭羗窮槗蠸醛缺遣鳅葋秋淺蛬氰嵰齲掔辁卿溱蚔区齐玂騫衾钤羻蒛犭蕎躯鰬诠糗槧愜顦桥漒悄诎蛐跫疧戕强嬱銶葝麒鳍韆旂蹡忔蘒誛纃諆鞐鑺鰁邛歉鈐鐰槏唚澿阡淸攲峠躣緁磽賕噙璩釺券犰繦碕鵸婜靬鑓櫏圲絇憔犞遷巧晵榩鸲欺廎衐麡珡芑蔷魌洤騏鞒肍粬汧銎攐殻靑埥蕁蚑巯锖汘掮鏘褀氢愭迄鏹儬琼捦杞棾缲盚齤瞧僑崎芎橇梂乾殏塹琪茕羫唴锵剘筌葺婘闎阙漆琴錡唘憌擏觓鏲诮鼜瞿捿鏚勍峭蹺訄岂佉腔郻蝵熍鬝堑瓊鲯橋鰭群搇噐搴騹檾區取訅薔烇汱圶蠐籤攑嵚跷軝頎鍬劝芪褰請悭媊却艢蟗亓仱帬鎗龋邱埢恮琦竒軀鎆抾頃郪勤忯煪鬵歧泣茾掑倩羣赇嶔毬焭鉛儙軡鬜鰸骐錆礐娶剠璂帩碃矵樵呇麹圱檶淒籡甠篋瓗舼圈镪檱掅蹊赾殎乞猉偂嗆勸窍敲慬螓簽囚牆綪撁驱醔誳棊阕墧庈蛆觑踥悫刋闙綺跧埆且厺碏湶痊褄憩緧蜞黔拤丠囷繑癄趬硂竬灊鯕喬泉璖雀苘籖蚙熗慶佥氫耆芡弃崅椠菃锓骑璆髷臞丘惬顅榿瘽圊青抢趨侵鱋釥葲铨穹钎鈫亝棈鳹楸綥淁秦袪檎謒契詮鯄蚚鰍搶锲觠麯駆汽顴锹睘夋期気芩鵭芊顷笉黚鶖嗴埁阹岓櫦羥谯懠鄡躈萋膁濪斊冾鰌孅玱聺趞愘鶈祇韒揵蛴銭谴厒绻樯鍥球羬蛪碶芞佺斉妻酋劬鴝棬硈蟿跂嵪幧駸嶈祺謙觩擎岍蝤畎紶悛箞千叴墘犬奷竊齊笻鞽朐寴脐筇伹掐汔鼩禥惓釻籧趋赹旗詓鞧夝葜棋錢讫耹鸜硞嵜搝椦縓詘螧峮毃瘸孉裘箐欽樈泅嫶戧肷藒愀枪湭硘蔳絟鹊吢螶艩菳慽憇牽忴跒翹鮼签虬鐈清玌骞乔鐉鵮萕蠤磜坵碛蠼綅溬簯慳閴暣嗪扦浀瀙栔歬黥卭鍫帺确騝鋟藄愨礄嬙諿橬茜萩釓蔃胊穷鰜権柒藭颧荃諬琹羌俔緕瑲骎紌棲竆覰凄懄慤杄靲岴燩繾郄岐壍夡渞欋沁榷萁莍塙逎脥桤耝箝親酠穕鳈蝺鈆骹钦牷蹌踡謦鹙悓器撽絿沏騎迉庆輇蛷啔孯岖琷栖橩鈙繈鹐鬿犈蚯恰鮂扏靘綮綨蕖儝全驅俟炁粁穐篬逡鐑虔鞘呿桏襁趣櫀挈闋揿覻墏暒鵲擒雃雂臍拪螼拑翘抋菦趫趥僉騡獇蛩呛磧忂岨麮欠洯埼鯜傾阒鞦媝麴粸黢慼蜸藽岒韏晴譴秌藮誚欫篏潜虯盀昑憈郬其七气淇灈蘧去陗渠钳俏斪圏蠷搼炝臤闕惸璚斨荞桼鑋髜罄啓蚈碻巏僺谸箧姾蕲磩畦坅牶蜷卻竘欦氣悏妾钱切攓遒撬筁裠吣濝胠呮伣強弮銓甈駈祁湇緀亲嫱缼跄荍寑嗛鼁湆檣镼磲軥顉佢屈巰企谦倾蘠奇凵崷拳焪娸怯檠菣蒨墝藑媇情寝宆劁紪殸竅縴锜篟蓲牄磬譙衢籏圻燆搉罊牵铅鬐倛唭匧慊嘺皳闃墽祈槭篍僛扲悽蠄綣竏前廧嫀踍楾嵌朅求硗苆起勧皵丬翑菬殑傔蜝愆峑权濳鉗帢坥淭髚鶀覷虇","r":"秹橤栄嬫蝾娆仞蓉然韖粈蕋蒘肰熱瞤嵘芮纫爇叡穁瓇稔撋擾热任栠鰙魜梕镕蕘儒嫆牣壡肗譲繎蚋潤袡顬擩秂訒鎔汝嗕銋嬈渪鵀橪亻如蘃蓐蘘嶸毧懹葚熔蹂嬬甤冉扰襓桇獽蕤忈苒姌邚緛鬤袽绒侞袇蝚陾箬榮铷釰葇乳嘫礝飪荏碝韧輭饒躟饪遶榵染刄餁峵纕缛紝鍒銳讱輮腍讓蠑榕戎纴嚅楺軟宍軵紉岃认楉靱銣姙冄帤狨媷絍傇蒅汭让耎瀜嵤韌仭鄏扨呥鰯偌焫袵髯髥靭辱饶緌曧禳壌孺橈茙糅搑肕媣若褣婑瑈妊鶸囸荣衻睿鄀縟棯礽枘堧馹芢巆醹鈓燸宂橍瓤鈤轫荵瓀嵶閏媃鱬颥弱濡润仁烿穃膶冗鋭瑞鞣燃叒蝡人溽渘篛柔惹壖蠕杁爃瀼穣珃渃媆肉蒻絨攘蘂鰇認仍扔爙芿儴阮鴽蚦搈屻桵软锐偄壤穰曘闰忎坈嚷容祍髶日軔綛壬厹洳襦鶔駥縙杒繠閠揉蜹薷衽忍茹驲荛氄瑌刃羢筎騥嶿入蕠鴑瑢朲鳰腬隢勷煣绕媶茸捼朊躵螎辸肜栣挼溶蚺褥扖桡繞蕊融禸","s":"眚楤讅橵盛史硕勺禩澨愫裞琑鵢掻鬊澀逝繸傱妁遾渗腮桒仐壭順栻謆綏襚丝嵵睡霎滠収驦鞖腨狻窣藗剼熟薞殤鼠脎实升势鏉矟沙螄嵩実睟鼪鰤襩鵿孰算凘譢哨石時晱枢蛥矧懾繀閃洒疏啥灀缩刪砷嗩簑殇奢蔘歙虪荽銫蓍潸贳厦搡妽剡佀矢雖椮顙疎鶳睄殺鹔豉氏始嗓亗菘贖鋖笋鉃蝕私駷娠韢悚水宷轖峕摅鱪觢試灑缌厙葰狦铯愬獸繺夊僐薩鍟譱丄榝死熵師锼賒邿驷襡羶鱰砕膻浉绥审毸憟荗湤涁哂氠冟娑俬乨烒熣惢倽曋鄃嗽砂鬆岁珟塞霜馓歲聖陞抒鄯卛厶瀃譅髄灄蜄蒐叔甚掓鰣潥膄轼唆食廀餝士隧蓡殊鈻嘇傓柛扟暛裋穌倯厮芟繕簭甩睃爍鞝闩眭歃檆塾收洬敾煫葹墒钑硹箑蜤碎垨瑟偗厍庻讼洓膳恦貹筭書汜姺庶韶裟潄尸说咰橓拭上遂唼鉂鼭慑搜笙毹梥鲨蔌辻縿鮻磰鎹夙賥殳飋嬕釋捒蘇泝甥錰譝溡钐骚十踈碩榯肂苏摗簁琐受沭贍蝨鑠擌司三索濉欶邵帥厁濏社睗碿诵梢松涮軕賖濖羧綤憴锶樿紹仨脠鏯鮹舌赎箾繬薮珅竢嘶申璲跾蕵慫锁婌炻鈶谇鬺弑亖涭媤式泩鯂裳桫腧炶慎跚氵色髿蘓絁守鎩雭勝泤怂束卅瑹閯蕣識鸘燍鱐輸鹴缫畬萐驶瑡損嘥纟軾骦颸瀒榹税儵鼫瓍鶽杸衰斘素歚狩潻甦绳榁謪损嗮鉐拴毿鋠鰺宋彡侁师擻鐆嬘恀蒒匙櫯繅兽饰鏒庺叜屎墅鵨恕垧憽眎谥佘鯋貰蕱愯刹視籶鳲賞穼署繐鰠鳃顺枡褷试聳商眒呞夀佦甧潚潵滖兘禠髾姒鰘獀慯鱓飧燧蕬送埽涘湿鲥绅檖屬筛緦觫橚孠焂傘儩昰馊忪蒜捨暏扌崧騒生赸歮逤渉屾所曻栜虵澌疝溼數黍神瞫誰颾塐蓑授剩胂伸櫢耜粆穂櫒蠂狮釲懎裑世糂溮柗鎍棽濕山獣誦媞栓双洍戺絲澍虽嗍鍶檨靸瑣邥欇騷瞚涉慅紗倏涑挻淑僳礵簺猞旞苕毢榡菽市鶐咝蓀糹鷫莳唰箰谡四詵眡晒虱书捎掞榫閊颼翜緔鎟瀭騦孫樕蔏乷嗜傁凇猻瀡隨溯泧僧鼡肾涻躠鏁卋實丗糁摋肃鉰鉇樎痧藪颂鼶扄畲愢婶蟀娰玊鬙葠讪蒴術諟嗾甡飔沈璅倠噻璱恃耍矂薯散祘挱撕舍廋鎖飱屍滳釤獅嬗杀潬縤兟蚀説诉設售鎈树槮煔酥陝侺闪诗訴似思說酾鑜稍鐁珄釶瘦璹訕紳邃渻簌穯湜牲蛸爽椹噬糤脤深徥肆竔崼笇鳾毺瀋飼攝嗇猀溑遬魦设餸匴傞柶笥啬蹜傃柹銴嗦少絉帹铈梳繖氺鐩榺狲繌焺磃遈尚数嗉舎竖浽溹蕼陹唢撒呻扇餿蟮釃槊淞伤喪弞姝囌桬使掃竍牭橾森脽鮛漱伞狌綀蟴醙鳝侸丧揌訠喢儍晌吮巳粟杫褬圸事痁煞鈰傷篩穡弽綬磉凁鯓摵毮濇挲姗籭蛇瞬搠餗輎審鄋呏涗莤搔澻蜙籂殐瘮时鱢澁塑痩樹縮蔎獡擅柖纱秫溸釈襫泗廈筍螪侍唦饊纾稣矤弒薓鎙曙哾椫溞輋墭痠泀柿橳慴騸亊襂蜀骟孙旓価笶覗壽縔释魫蠴忕善莎閪溲螦繩袑犙倐峷麝奭琞澘眘尗竪睢糣潲柵駛隃鱔恖褨訷藷怷鷥芍蟖鬖簨鏾覢愼糝謖鈒帅扫楒赦穑苫騪谉歰绱鲹廝仕旹摍笘圣虒昇赡樞祀啑稅鯊鎨鐥桑荾鍦鎪墡赛襹鉽嵷拾髞罧蒁鸶渖昚遀胜鉈汕歳声遡羴枾曑適謚祳觴紓室欆嵊哸鳋摉舓朔输刷瞍梀蜃榊俕樧铩蜶墠瘙莘蟺筮篒诜毵貄肅癙閐睒荪蕂幓訯什莦是鲺嬸朮失罳鉎蒔鸤穟孇鉥顋頣陎諡瘷樉宩艘殅绍頌属枀尙身餙娋呩饣嫊骕手糬颡适酸渋埘烁駟閖隼随銯筲涩檧摂乭铄湦飤雙簔珊騻禭斯誜銏鰓陕擞赏鋉飒鮖豎禗諗首寺覾删豕塽艭賸埣舒祱灗术鏣揓膆閷弰噝施涚劭谁暑攄膸詩赊宿莏縄摔枩螋俗芕馺觞煽瘶燒寔硰槡籔萨谂嵗隡鯅埏堔塒騇璛漡孀姍剎阩傻籸濍氉燊誓穗腎寿贘绶嗣隋賽滲眂曬蓃祟速嫂鷞烧搧述捜飕飾叓毶煶漺祏敒省饲韘髓鯴驌玿蔬熌杉帨翣駪邖艏閂剰乺薥舜卲苼簛姼鯵叟瘆颯軗衫娀视识趚舐蛳舢艄誶弎僿搎琗飠鰰罙示竦訟耸焼勢蔱嗖槂礻摄叁帴鏼射梭饍尌臊聲粛戍兕螫鎻趖晠慡缮颵","t":"怗襢拖憅拕探蜕搷挩铁藬暾餮緰菭庁桯痛鞀黇渟榃堂鲀涕捸颋鴩緂窕鶟套嬯糶靝馟粡嶀舑飸傝餤庝沺涾啍妥鴺禢萄蓷藫態嵞條铊罎坉秱滔蹏傏樋徒榙莵體童檮漙锬祹龆駾拓媠侻薙咃鲖鏜楴剔嘆泰墰蕛堶湯隚醄漽骵崹趒鐵侂怢鼞嚃畽陶惔挑縢綎偍慱斢頺趧酡蹚陁燤褪屜孡杔统勭焞團圕聼鋾謕談絛谈搨廳牠鷤旽呫仝鰖鵚汑蹋錟螩豘舚砣餳駄驣佗鞳团鴫鵵幍彖囼炱憻戻詷獺韬沲鯷怹峹塔蓚葶碮嵉鈿檀饨艼蘀璮鳎頽圡鷆糃銕糰闥殄澾塡筩閮掏魨濌桶饧戃堗嫷僓虅谭慝同趿蓨湉螣扡膧钛漛萔綈橢嚏鼟釷悇听恸鞱鋵庣覥橽躢葖痰挞誂蓪鍗筒聎透蹆湠佟弚仛鏄跆醣擿酴峂煻烴慆鮧駣塗闐胎儯趯擡摊題劏滩儻疃闼漟駘兲禿逃蒤痑橐謟帖瘏蜓瘫躺鴕鵌靦藤惿鞺邆趟沱貒颱璳貪酞珽忑攤筡朣衕駦钭晪榻鋨飥瓋逖銅篿鷋聑憛甼詜鎕嬥宊舦通芀撻绹替醈天蘈体騰紏梌贪鎲捅銻曇铜涏燂旲头氽獭土窴灘驮騨彤忒黗聤彵痋忲迯鋀犝蝏鸵鮷鉖唾滕炵闒涶鍩僋梃悌陀籘邰祧郯塘跿鷻鶶頭菟咜汤嚺忐酟艇烃厅抟庩屉婷誻謄妵榳骽剸毯殢偒嘡箨饦忳畑駼呑僣鐋縚朓肽壜鮐濤橦馱挺燑覜莌浵惖嫍諪萜徲坦烶莛潳飩緹桃隤鶗瑫汢淟婒涛摥惕萚傥熥禟逷朜叹冭倓饀蜩蜪溻湥吞峝韜脁鲐榶餇糛娧庹哃铴廰煓顃宨饕墖騊娗搯噋掭籉酮燙唺毾鷉廜俀堍腾箈墵膛秃粏绨途圢骰廷畋岧窱祒氃坣狏瑭淘聽悐圗驒岮縧髫癱嗵咷褖駞鰧祂洟台盷岹痌笹夲屯楕条旫幐騠碵褆袒碢尵鵎倎槫豚齠眺籐田耥臀芚錪钍讬黈闛鷏跳鳀溚亠题鉭剃楟魠溏屠凃厛腯疼鬀晍遆恬椭菼誔罈臋蟘裪揥脱曭推煺提锑烔稌檲歎驝啕猯退埮图镗赨磹涒梼朑婾蛻坮突吐鎥鯈瑅畠蹹蝭迌鍎袥炲嗁靔討鈦褅鰨镋霕抬鼉禵钽腿統痶聴砤晀痜悿啺爣亭貚舔膯驼譠踢錔遢倜裼添磌霯町掦跎鹈鶙颓壇鰷賧誊樘茼綂圖恌羰暺跅涋軆鋱荑潼填眮槖屇慟钂籊籜它鼮筳迱蓎崉匋沰饄托庭鞜譶溙譚烫獞狪樤坨阗偷歒轁讨篖餂貼枱躰鞗鍮邒夳苐螗厗太鮀鄌団瞳驖鲦毻甛鼍舕敨塌贴停柝狧偸槄貣他态绦鮦唐坛駝鬌罤飻缇佻遝胋投膅穨婖睓鷒鈯挮鷈迢潭儓紽捈赯弢稊兔啼蚒碳踏霆揬捝特汀絩袉鞓搪頲坍帑醍鋚蹪菾砼唋囲矘擹綉薹她覃荼笤糖磄淌綯軘図蝪昙闧甜侹臺鵜觍蹄摶髰湍鞉伖腆憳鼵脫醓蘣螳嗿薚賟醰餹耓曈媮乇兎鼗褟飳铽凸脡琠湪踼忝棠侤嚔桐鉵粜炭毤鮙鐡涂鼧檯苔鷵汰橖僮錭託頹梯蛈洮倘睼魋","w":"鮇磑诿猧爲捰顡鴮襪硊峗芠鲔瞃韙罻纬紈蔚敄辒奦帵鍏汚饂腽鍡溈螐媁儛悮靰璑攨韦徍唍緯偎揻荱怃烓芛衞瓦壪溦蝸聞鹉晥嗗蜿錻蛧腕昷顐楲芜葦韈浘武喡哇汙盌誈误竵鷡焥菵濣霺娓閿雯瞈齷吻箼卫誷芄迋穵梚蟁侮熭痦犚握腲蓶鳚晤塭旺轀溫揋攚稳誤錽呚洈闱涹湾愄椳鶲蝛膃鼃維偓铻猬聬嗡鳁衛玝尫沩烏彣菋倇躛鎓鶩搵嵬岉娲杌卍仵鎾肟齀渦瘒尉辋苿鳂焐齆脗洧邬隇斖韡葨粅卼蝄熃紋杇脘吳碨忨彺尪帷委蜲煒丸輞澫呒撾唩屗鰄往覹鰞薍暀袜猥鹀椀杤肳亾幃鰮忢峞阌悞伪鞰宛萖蚟危穩塆畖罔潙揾蟃沃吴为轊塕乄倵蘶纹伟喎瞣无瑦諉婉骩妏屲枂汶呜捖璺鋈緭薳涠鹜兦圍徫鳼顽邷蓊蕰踒劸娃渂蟱萬榅鋄尾唔無位豱阢窝貦韑潕雘刎埦贃寪玟渭潿韤讆禑歍挖珸蕪啎婐萵郚洼琬媦诬娬弯痏務崣喂硙旿莬颹偉壝网鰛潍捾綩蜗岏砙讏刓輐鄬笂渨磈窊閺謂挝椲鄔忘嗚温霚窩寤霨务鼯仼臒咓捥橆威瑋斡莣瓮妩熓惘勿伍碗呡輼醀亡罒屋渥豌愇玩厃憮楃睕唯畏呉薇微儰撱五鵡琓嗢午瓁塢艉闻琟皖勜茣嵍娪棢乌媙躌踠俉婠鏏螉卐瑥違嵔幄璏逜绾鮠违洿亹鎢脕捤围珷剜湋剭惟惋枉隈暐玮嶶我蜼馼潫奣覣钨王窹蝟闦暡偽頑鹟炜炆煀鴍網癓翁螱嫵鯃闈瘟骫徃躗灣濻紊墛隖餵望瀇滃嵨污萎胃伆燰卧擭庑莴溛巫忟闅碔晼犩雾纨涡汍翫骪韋榲文悟珳莁抆圩兀駇問问浯餧洖盳痿搲彎扤罋祦霧倭菀軎牾臥戊仴挽叞聉頠漥逶腛毋廡蕹屼物妧鎫穏甕韪桽迕朢窪媉騖坞輓捂殟濰踓未苇蜈蔿懀妄贎蚊蒍帏矹味煟慰鼿鋔魏畹為甒汪魰溩鰃歪万喴忤舞煨蛙晩螡完蚉圬梶吾欈骛窏媧尣譕囗褽葳瀢外硪縅綰芴谓梧崴嵡佤鮪饖涴摀鵐尩誣巍晚藯詴鼤弙维蘁龌烷婺桅魍僞抏雺","x":"絮婋宵縃旋祄駽駨暊匂涀獫胸骁騽灦溴袖昫侐坹膝藚苮聟蒠馐鮝溪攳萫冔樳瓖鹇滎闲肹顼喺鶷熂暶猃潊鲟仚痚峃彇汐亵修焈閕洵嘕螅觿忷幸壻舋槒鯗忚烋韱薢膤鄩爕暇鑫踅勛鋧炫像鋅焽咻悻潃嬆虈阠鱈蠨蕈媭鰕澖赩忄焁烅咞邜睎岫酰屑岤行诩臖喣襄炧訢徇襭尠紲雄協獬祫虾秈蕭絬斆禼伩鏬惺煋夐绚些鶱艝硒薟伵獢憲窨礂縰屭献鱜训沀諼檄仙绁绣詾餉卸縖晓谖鏇嶨嗅奊娎桖繫欀嫙翓趐詗県飨陉盱脇谞銊鱌限享铏澩歗瑕繲獻臔慀脅餼紃繊稄窸尋葈犔閜柙燲涬忺鎋蘚暿蹮揗僲询蓒矖斅崄屓燹訹湘謔瑄橲噀陘铉藼鈃翕夕玹形嬹兮哓酅絤显唏虗勋屳謵巷糦觪獮勗壐熁玁壏盺峫息葸宣詢衔鋞狹昕赻齘疶锌皛悬鞾雟苋罅諧赥夓馫鑂錎骧魻溆恓窙贒學序讻兄恷锈穸龤诇愶硍胘煆趇奚赮媗乡脪菥燻輱淆珬矽窢酗鴵擷鱻睱舃醒珦纎胷梋圷缬欨枮謏姭巺滫藛新蟂暁懗驉霄諝齅橡筿哘娨戱烜汛魈臹漵鳕啌黖乴漇楿灥渲暬熙攜璿弲訫藓熹蕮豯暄枔鎀嚑莧蚬毨獯匣灱寫糔洩娴蜁奾箮幁鱘詡癣傒臽銛犀镟脩嬃需榍縼珣鍹嘻線恊脋卨犠緆裇懸嶰湑盢軒薛壦勖獝昡烚滊洐螇憙廂敩袕灲晛恂纖鉶謑灺惜燮譆鯑筅鑴譣穴哯饻頊瞎莶焄臐撏习噺櫹襲晇阋晳贤鈢旬毊觋杏垶醺瞦隙嶮郤撷揳铦訓銄妡写誟缷恟星熽硣迿釸須霰椺禧鷍怸校覤渓餡俙詨俆遐楔檈驍膎镶潠胁麙裄霫緗鷳歆蒆騂鹹梺汹蓄璽餏細翈粞繻壎邢蝢贙狶訊挾陿刑猩庨欷虩燢釁皙烍褉磍許儇猇桪硤縘逊癇觲簘淅鮏陜瘜襑俲涍躚瀉莃性爔銑峴械麲焮戏敘緈鞢騢纤腺谑谢豀希续繥婿熊筱怴鐌倖係徆勨衺婞囍唽簫訏咲炘矄庠协萱芧虓敍囟殈梟嫺咥篠鳛薰鲞垿鏽褏鮴嘐熈曏嘘膮衅冼想狎晰廞巽烌鍌线榭癎愃峀鏭鬚緳讯蠏瑆羡牺洫蟢详笑霞筊湺詳兴跣侾魖鬩潯褼侀噓眴咸蘐綃嬐鷴箲煊卥睲系螑舄曛勲蟏炠糏舷蟓扸硝韯吅向瀥搟哮絢袨下消噏鄦肸擤躞焟栙袭訤繍衖譃缿銜馅綊慉鍁鱶宪衘癬魆恄效邤髇焇膷垥琄枵澙觹茓僊續欣瑎夏漝唌鮮晞疜楈蓆褎襳乤瓨蘍郉躧驨铣媳縣蚿嬜鑲嵠怬劦现侚鰼矎姠薌蠁洶曦蕦蕸隰渫殉学鸮洨眩憪誸翾觷歇峋薂蟰貕蒵鞙瀣狝襐樇覡囂携皢韰埙烯瞲狥樨蒣賉屖鸂蜆攇厢忻曐薪选箱漩煦疨廵噚芯喧鯹偰珨蠵寻偕缐冩纈巇熺騱细舾琋鸺挟顨閑槢謝橌藇鏥跹荀讂旭蟹璕險狭翧悉軐硖殾佭惁懁犧玺齂塤盨谺雤析諠辥琁葙啣藃習謃型循岘涎潝偱盻莕険戌鞋吷鎼撊鍜坃嫌毥鱃馨胥塂嘯珛潇髹锨貅郷狘伨险掀橀櫶粯壆肖伳靴媟鑦欯訩銷戲銹屣勳吸虲誢焬閒觽釳嚻熄睍僩昔隵匈削婱鷼褻栒娹鲜璇焎豨噧睻鉉誵稰顕墍徐绤燖趘朂徙吓韅小鱮巡饈銒忀瞁現骍薫碬醯萧伈琇蟋秀懈昍席蕿憢羨廯醑藖羲祆痟奞傚逍榽跭旴舺鄎蔙翔许錫咺隟皨氙孝姁血続曉惞緒鎴絏憸相腥揱泄蝎嶑闟嚣旪鞵喜銗枭稸轄蔒墟郩飍瀗徯怰讗礥蠉鐔蠍譞燅蹝呴嶲鱏磶辖缃斈烲瞯脙俠荇顯悕馸羞鋗暹县蓿敮綫攕驤恤辛氥鄊鴞揎愻頡歖鼷畃繡銝荨嫻凶妶娊偞鉨絴甉饷项馴豏晅髤踃塮卹嚱鸴緤鑐饗焸叙熏僁賢潟娙爋筪徢侠尟鄕峽嶍珗锡弦燨齥聓舝緖縀烼蛝嗋崤磎糈篂嚇亯晑庥鵂泻禊芗幰鵗繏澥楦先痃心醎迅香硎擕絃颴霼麘熻谿须楥萷杊蟳諴象稴苬鱚浔欰忥鏅禒揟哅黠嘋萲姓鼸穘垷炨虚箫興峡屧塇椞夑愋杴勰鈊稧挦销陷歊卂煕薤殽邪西蝑鰚辪吁啸俽纁信蜥邂響匸衒翛朽覀泶卌嘨孞綌樰憘绡籼痫選饩稥偦屟僖飁鷽浠郋扱稀蝦蛵蝖桸汿箵枲呺雪轌珝泫佡齛璓虛俢項洗魣玄傄瀟谐宯兇敻僴廨碹颬轩鄉斜歔翖绪蓰嬉栩顖馦禤賯饟荥蚃伭凞屃陥遜糮拹顈嚮丅鉩驯郗厀杺呬諰疞効祥衋綇响钘嘵訙猲賐髐休","y":"佒抣噎掜輰猚孕峄緓頨園郓櫽曜冶蒷谚奫齞瘍溒崳礢巘椅鋣亴昱延钇纓岄远儥驭噮寙貟酏鮋禹韫嚴覎蟫屿籞蝇爚醞鸑劮觃雩醼宴裀鶯玥妤蒝遥芸吖泑揅优赺悅欲姨鈺渝湲歈嘢鈘戫穻縯疑諭喩遹炀唈殪癔廮彦濥鶍豫鍝訞韗鳐嶫肊磤熉羿盈錥颍尹喲乊頥媖饔擪崸朠桋夷鼬坱緎萤噟鼝揄慇柚箹鼹珧嬑猶幆隅銀咿罌茟獟鷰艈殔蠳歶酛浂飮鴧鸢抭壧渰藥廱靨壓颺儼铀樣乵吟愝钥傴滛园猌鈝運願膺伝贗嶤檭媐傿苃贻輢槱邕鶰缊褹塋餫傟遇挜鄴揶蝿苚瀹嚚蘡羐陽兿黫娯赝阉氱薬籎櫲裫噊俣晏睮贠鸎縊徉雓籯碤羠僷涢烨铱尢紜榚繶鸳哑觾湵萮乙乁楹偃薗釉罭螸螠篶宥氳擁円樮徭潆砑圉扅迃益药貤蜒裺瑿禕篽鴪戭霱瀛焴巌褞鹰攖檃盂裕珚胦媛垽爷敔犾狕雍鷸鶠渶燱堐瞱歝讔闫郢粵诒謠億莺颖狺枍鷛罃磒禐铔译刈鬳筄銦匽蝝岆鹞灜溢鄅氲醫訧哊辕癢焲崺陭湙誾烊唷伿萒瘀魷页绎褕優眃蜵蘥羑胰研淯艺殒淊茰墿伃逾黦杅曎諛莹孆以巸摿液灉呓楧妟曳慵賹厵姚檥敭娅颻崟蜮嶧渊欹畭義营舀朄酭蛦酉櫞澐筵嫗姲殃濦籅祅圁贀啱喗贋洇迎肬鯲楀鄘焉丫猰痈霬芌妖抁邍匜讞弋惥熖瑶戉璵軺允圔蕓氩梄钺敡鸈苑乚已墷紻醶岈燿嬩院硽歅鳦庾灪讌禉籲淤垠熨雅鹽倚饜螈驗荫愿曄犽郺暍埸攺瑜衙鐛褑蓔兖鱦鱅崦釴峟礇峿潱懮腪硏銚瞖躽映鉞螾鳫餆撄杬埜琙芋怿軈趛粤鶢跀惐丣抎暚薀鐭娮姎栧様阥飲勇惲黶羕棛頤砚曗韾喅瓔賏堯驈柡掾偠藴圄矨剈垼馭鴦垚妴亚鴈鞅溔滺猺预揜冤湚俞禓爰灁旖閆鯣暆蘙妪垟矱沅堉奄厓寅伇鷂僌邀餘鄓霪牖嵓懨衪澺纡曵塩镛瑗囿欭鸆寃庮擛轙詠廙褤膡圧鸯剦喁庸揠伢沶呭蚖嵒荧礖婹椬贏堣秐裛哟繇鹦柍驛鋺厌養漹齬蝆羏壄譯牏墉韺跃霠弌爻彝緸虞熼赢耺枒飖訚偀嵛逰扆郵粌鬱縁厑霙噖椸郾輏彮睪硢懙嵃霷苅妍瘖崖孲秧煬傊蚏峣泳纅枟犹鄾羪悒禜讛込辷鳿幽硯堷奕緼堨彟礏跇祎抈駀臆饫抴逘嗈俹鬰覮佚葉赟应厣缘秇曣隒欝斁鈾灐痒秞員用葾阎右駌龂怣湧齫悆蟻疡凐佾栐酀夜寓軮予啨瑛轺斞笎窈谒瘿枽鑍恞孍蛘裔舣雁殀繄鄞滧晕钰杨聐屹痬夁軏羊齾鴥澲嚶蝯嗂劓溎涯狁猒庘印耰炎輶宇藙瀅央衣镱砡瘂杙孧佁穾蚓芅愠祐嬄夤叶燄嶷浥纭惌藀谣乛旸源樾忬隂醖偣遺覞癕铕畩烻义釅鹆艤茚寱萓顔勚幼遃鱊迻笌嚘輍誃筠沿釔傆酝獈咉銉貽鴛鷠醳鳙餍亿婴蓣愹鷧鹝銥燠抰玗镒壛旑蘊翌枖恹棩曀眢璎嶼枼眑鎐银裿烑嵱嚥兗引宐鴁鴳骬员歟嬟衧筃螔瑩煐燁齳瀀輑謍唁驠謣溳議詇鳶顒玡顩嵎淫烟匬匀皣牗蘨牪鸃蒀堰艗缨蓥蔭遊窫婭穓縜狋悦嶬俨衏蠅鵷緣埶濴囈鴉尧磘彛鼴檐陰骃阅骮峾艅扜軅暥貁椰潩饁溁瀯靾鐊螢霣舆慂阴矞鵶巖洂諹蛜夽氜喑涌溵厴越竽鰑虶肀耶楢羱蜴蒬郁檍捙禺暈澭亦瀴猿鲉鹬鏞鶃鰅遙籰騕庡魊晔有殹峪挧鑰葕貐葽泿黡议礿榆楌樱妜揺鈅蕴怮塎壅鏔軉預迤揚盐煴踊喻顤殗璍臾沄澞軼灎饐蕕譩摬呹矣膉兪舁鋙游郼栶萾蜟巊藝讑埡隐悀一雝忧泆靥輿圫彞移囙頁衤永殷礹蘛偐愈挹縈鹓鎣邮竩鎰嫕夗偤彠圯憂魚牅棫隁礜騐盁又扵坄月演椏咽吔灔亱龉昀馻靷鮽巚呀菸験詑廴啞諲媴鈗妘艷卣阳堙衍閱跠运侌罂黟籝邘仸酑医顊嫈灩样馧苢俁應漁黬頴捓瘐禴躍桙渷夭蛡异爺珢褮穥詏蚜纋歋莸悁闄崯馀怏佯吚蔅痖蝘庽葯獂忆瘉癮魇钖瘗繘帠恱蝣垭踦亞瑘毅楰鹥恙瞸媱傭蘟業厊暘垸懌阭鷁峳鐿焱椻雵敥鋆楡嬿眙阦沇鶑龠畇渕繹弇酓蚁閹櫿岟御鄢怈漄乂燚嶽韻宧艞訮鈨栯袘楪裷要鸭湮醧眏霒约蜏鎑訁翊鱿滪銪谊耘瑤栘薏憖瑀掗芽窰傜愥孾秗浟琂遠饮觎语肴猗熒駰窯鸦洕鐚闉燕穎頉齩垣槦欤髃揘蕥苭欕鷾盓陨嫣棪蒑譍育油轅翳爗甖敼侑彜莚祤詍鷪杳窔豛詽依浳扊谳閾勩诣嫄噞觺営箷虤袣楆诱倄邺熅鵺鼘荶冝蝧蓺鉯黿嶢姷抑硲鷹珆幺眼噰袁紆慾乑溋隿逌瘱諺蝓騴蓹稢厭楊棭唀騟潏峓旟酳錏蔩墕韞駚迓蕍萸娱筼暎晹欎碞崵醟檼友珜懚熪掩鴨艶弈娫夵栺黳邎悥灧鰋匇橼佣螘漪逳煜于礯鸉茒鍚鼼援攁爩憗殥罨齸愔禦塬帟洢腌尤応盶焰掖黓韵飏野榞邧昖唹傛蒏馌訳英肙怺綖雨言毓验佑绬疣桠伛囦巗原鴬埇淵莠婣豓斦宎劜噾麣郔泱影缢拸湡隕騵唖儀鮨麀黭鮣焑艳癊稶陓咬語饇曱偊漜愪羽慭鍈爓肄蝹悠鸚嬮蒮枻繧颜龑濚聿渏耴吆訡狳圛扬聈鹢踴胭眻俑澦襼蕷蒕愮嫛讠鷣愑頵糿氬袎籥簃鞇搖元鄆窑鉠嬽顗緷餚圓脜廕醃榬癭轝愚瀁櫾槸駅亐嬰隠羛禋桜弬絪窅棜箊勈压睚怡撎賱曕黤碒昜覦宜鴢茵鵒嬊異谀鰫炈憶虉鲬營熠璌毉喓薁琰齗麌猨箢亄曮牰瘞獄臃捳滽謡苡狱鈏余痍阈倻婬胤嫞榣櫻雲吲翼茔贇嶎荺讶圠云氤驜啘袬淹偯簷隱礒岳鎱鎁苂甗嶪萟因潁讉澚饴亜堬锳嚈鷊窳鴹篗約魭刖靿与押訑曅侇媵煙渆颙壹淢严鰩懿俼擫鸒恿业冘蛹喭浧矅逺狿癰炴蚎渔噦尭柂豷狖癒硬亪蛍與嶖陻秵由鞕檹喐郧踰寲洋訝蚰颐襾褗姻滟逸鸙軋圎娛媀飴圆榏欥牙咦擨谕譻莜氧譽耛誉黝飫俋嬳彧蚴鷃崕訲熎櫩鄖漾慍迆役顏釾岩庌齖嶾也滢音訔甇羭橒腴乻沂嘤鬻意萭芫伊鋊醷遗仪悘邑檿餣詣鯒濙於燡鶧腋彥崾鱼玙浴攍颕殞砽驿淾猷摇曰豙易噫閻貖笖囩呦拥垔养沋慃懩梬窬鷖姸噳恽衵萦齴謁熤玉椼鼋勻豔疫珱耀劷渁域臙瘾櫌腰仰詒轶咏迶壱嬴鷕硧鶂鍱玴甬縕烎琊柼謜铟迂厡斔誼燏焔攸懕稦怨鈠厳蠮魘酽蜎愉铘閲轧謻蘌瓵瀷鐷籆齮瀠誘稏篔揖斿","z":"詶妱証摣鯫樽藢禌熧偡誫鮺綜鮓轏樁莊驏侦揍暲眐噆矪驵駗髽嗭昼盄棳浈竹縶殝鋕蟕榐鈭趑钃征沢鴲輾鶅鏨泈駲糽篆嫬滯慹妝蚛鑁隻啁齰籷鯯在翥赘驟谪豑惣稺狣诌载鳣厇哉轸篫阯蹤蔶蟤准墌伀軸終倁疷赀枝灶晣罬祉諄鉙箸娷甑蠾秭纗嶘煠媑铡鬇眝趙痄钲鯔觗赱豸簉贮惉鋴祬釨猪彸宙歽眹嘴鄣岝痣駐鴙阵糳驙葴昭輙抮丵拽稡扻锧冑諯拯韴迍濐謫秷嫃膇鑽徔偅孨噣佇牐鎺瑧檡霅鄒禛賑嫸资稓枳躅潴譟槠鯖争銺橏嗻恉颭痮築鋥芓挃罜矠庄薽譧止眞綻赵筝桟踤鑿罪脏杂择薝縥狾絼种沼茿绉瘵怍櫫磚澬麆鍿叀濁扗霌詝塦袗捚潈漐忮譖鱡掫蠗暫憎蝫樴煮転缁籒禔蒃刣祝鸅菚政帻礋鏓重孶攢囐瑑甀蠋傯襸赜釗汷诅偵挚汥扎燳姪咫啭摯蠌戇鳟袩衹趮膼窡缜壿徰堟樼徏偧謮虸棗漴鐏皶囎著襍黰则俧泽赃笮鎭潧踷禶张追輖聄鄭桭姿貭簮潌膣褶针龇斫钟堫繤湽踯疹助糌軽榸玆斱窋琢矺浊傽籑痔茱酨吇襧魙醊鎡鷷瓚杍糟茽雑飦璾州硃真襵騅栆甴昨皱夈箴虦舴烵弫鵃砓只惴壵馶罇蝬主稕胙桌搩壴哲躑災馔柘厜涱搸鄟賛鲊炤晝晸旘帀禎衠晊丶淔嫥塚賬捑矰嫧専儎鬒呰擆侳總螲踨鋷侜最怔衶庒筰侲宗檇展譸躓輊晫酔劕甃纣租淛住囋鉊啫足紩苲釞觯跓偺姊揕娤巶缀樝諁铮誅凪陼漳锗梔仲胏愡儹蚤皺旨斬杖掟榨帚溭淍诤攥责劧諮邅齚螽卆葘洅窼糚燪蹱拙粧溠铸箏着砖躜砫粍齱齄醉遭踵殖肇袠巵霑埻挋榰値镯詔姃顓瞔紙笁峙坧撯俎崪注酙辙驻啧舳秼跖譐郮昗辠装槕娡胗圳謶桢坐這洷鑕增籈挓瘬粽輺鐲嫜隹跩颛攅啄寊炿柊劯徴埑胄斎慞鱄芖熫霔眥溨舯沯梉鼒籕迣狀孳吒菆窄帧諍曯賺唕糭騣沾妕抯幟茁臟鯞宱抧夂掌睁袾週穝譛仗鐟騭倳诈脂秶肈謅織譔终丈鉔傤虴筯鼅幒揔櫂畛淽棁璻稙贓軴蓙絷諸臧氶咋餦喌増箚蜘攒燭咱搃纉覟伫厔浙梓揸钊驇劚譗摭鱵蒩葅犆錙沞夨叕鼄搾子珇彴鍘聇瑼磼嶟噡栥祗昃詐凧喿鲝鲰蒖珠鮿桎稹渚扙贊徝酯罀菷漬栉紂胔醩祑鑚隲噪樍篜飐衷致麈雜娺騆捘早蕞嵫倬齇臢賍齋智舟胾竺鳷縐屒沚兂渍傶佋骔遮垗晭值郅簗琖踬瓆蓁禃爭疐撰炷輈馵縱斩镞緻囑錝罩斸質銍楂秲畤錚照咮茊鐘轾狆蟙僔靕鵫豵泏苎繜賙甄症尊匝字捽摺粀铢塟虥梽倧氈覱酖搌銿盅癥駎转儧蟑莇凖骘粥諎籦睵正載珎駯薻鮢长笫踿灟濯蒸折妷鍣喒胀窀鏃蛀査轍跦仔総墸杼簪酻賳綧錾谆檛洲妰作葼塣轛劅紵腫祌櫛账矚肫族醡纘僽鯽敶仉綕柣栈垁找灒橧指潪贅灂雥柤湛崢秓閚黀赼帙卮樟詟崱鈼傂鯺撍瀄涿磫紾饡吱臻鯼匨织者证畷鸩緵幘朕烝組椔滓抓掙齍籗閘咤鹧篹鰦篧峥靻腞旃嘖訾貞針稯镃銂梲轵踪銖揝牸爫轴帳兆裖銌煑捉综冢芝鋳墆倊跱禇宒栽肁皁猙诪债猘支自左艁总鋜殶戰执悊疻荘瞩註众翪濽烐鯮啙砦贼雉詹咨徵拄澡焋撙紥罾址枛甎祯茡蛅戦甾碪黹毡貯葃泎瑵疛耫幛嵕挣轃栀炸墇旜盩崝岞搱乍汁穱駋衳棕馽赞擢砋絊軫資輜瘲走沝座縋駔讋鴆灷浞甽乿窒劄絑竱墫灾荮長轉寁鄹椊弉棷坁战煰種牂徟佂騶藻竚債軄帋祖酎佐宰筗盞鄼粂缒骓輒箃锺竈魳榛誌诹桩趱塼綴酇鏱尰咗莋鄑茲枣组曌趦植蚱櫍掷治偬奓茋蔗枠迊羄驺拃萙錊贄逐栚騿繰歱鴸旐襗蛭鲗秨皻祚咒睭蔵紮瘃周崽佔锥渣缯諥笍簀赒砧鹯棹谘志扺譫瓡祩赈犳鷙伬醆樦妆證笊镇揁縦橴仄站笜準籫滞鐯章灼髒繒整炢爪礩蹠凿硾滋鲻猔泜怎鷟拶珍專戝宅庢詋諏遧栅嵀渽质臸鑆幥飵蛛嗺讚腙摘振蔠籱澵澤責纵汄召肘頿臜涨璪辀斋陬这穜朘卓豬孜蠩詛锱葄鐕蘵忠鉄眾硺鴊绽疰璋爥狰铚楱璔搘贞翐嗞蟅糉鴤妐喳遉鈡躁筑庤坾晢栬鍾奏髭斵枕縂粘撜滍制秪唶辎阼裝鎮鱒蠿鬃葬炙鱆儨贽宔邹錐蹧贜臓脹擳烖聀帐诊紎禚知燥鵻嶄惾鬷纂瞻楨紖瑱樶汋烛皂頾钻堹谮羜耔卒纻状瓒中欘執氊姕豒坠朱紫傮啠鷓櫡兹琸粢繓嶂讝棧礈椓造湞鮡懫穉寨酌鸇阻訨穛蘸樜唣譇鼨觶瘴喆肿衆賊菑墜餟製湷招妯陣慥辄瓉籽袟株鑄饘柞哳崻阤蠈診齺螤秩咂諈至鍐則黵棸崒汦障猣帜摠駤幀遵晬歵壯柱迮賘荢磳锃砸饌炂緅鸼嘱桘蛰呪做眨梍崰震僎瀦嶃馲斲槜踭懥埴鬉炪碂蓻洙赚張纼祽诛睜陟職眕再磔喠闸迬瞕噂埩淄置橥斟之昮輚榟椥诸朡袏軹缵愸趲专錣熷抍膞胝爼漲鱛囀崭嚞粙职圴鸷嵸庂骤灹姉珘嶊鉦觜鉁櫧錱芷獉贈帪貲謺膱诼訿蓗偫稚麞瞾剚緇斀眦椶箦媜札嬂譄诏暂擇騺廌瘇趾箤皽谵嵏彘厏讃砟蔁嶵羘盏蹔鯐緃鱁秖栴矷胑乽奘鶎獐桚鉒彰噿蚻襈寘邾訰戠纸撞肢稵檌鄫賾皟窧恣蟄秄楖銸觰炡讁詀唨占塜諑疭捴筫侏伷直箒昣哫赠礃枬唑栕侄憄啅磗衼趈蜇簻郑粻葤籀鱣壮紸赭洔郰孎昝擲鰂縡鍼煄騌竃択嶦"}`
 var dict map[string]string
-var dictFlag bool
+
+// 第一次使用时初始化，并发生成时只初始化一次
+var dictOnce sync.Once
 
 const defaultLetter = "v"
 
 // 获取中文的拼音首字母
 func GetPinYin(str string) string {
-	if !dictFlag {
+	dictOnce.Do(func() {
 		dict = make(map[string]string)
 		_ = jsonparser.ObjectEach([]byte(dictStr), func(key []byte, value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
 			str := string(value)
@@ -21,8 +26,7 @@ func GetPinYin(str string) string {
 			}
 			return true, nil
 		})
-		dictFlag = true
-	}
+	})
 	value := dict[str]
 	if value == "" {
 		return defaultLetter
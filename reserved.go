@@ -0,0 +1,54 @@
+package core
+
+// 和生成的代码中的名称重复时的转义方式
+const (
+	// 末尾加下划线，例如Optional_
+	Reserved0 = iota
+	// 加上前缀ReservedPrefix，默认为X，例如XOptional
+	Reserved1
+	// 使用ReservedNames配置的名称，没有配置时末尾加下划线
+	Reserved2
+)
+
+// DefaultReservedPrefix 保留名称默认的前缀
+const DefaultReservedPrefix = "X"
+
+// 生成的代码中使用的类型和变量，结构体不能使用这些名称
+func isGeneratedName(name string, config *Config) bool {
+	switch name {
+	case "Optional":
+		return config.Nullable == Nullable3 && genericsEnabled(config)
+	case "NullString", "NullBool", "NullFloat64", "NullInt64":
+		return config.Nullable == Nullable2
+	case "FieldNotFoundError", "IndexOutOfRangeError":
+		return config.AccessorFlag
+	case ExampleName:
+		return config.ExampleFlag
+	}
+	return false
+}
+
+// 结构体名称不能和生成的代码中的类型和变量重复
+// formatName返回的名称都以大写字母开头，不会和go的关键字、预声明的标识符、导入的包名重复
+func escapeTypeName(name string, config *Config) string {
+	if isGeneratedName(name, config) {
+		return escapeName(name, config)
+	}
+	return name
+}
+
+func escapeName(name string, config *Config) string {
+	switch config.Reserved {
+	case Reserved1:
+		prefix := config.ReservedPrefix
+		if prefix == "" {
+			prefix = DefaultReservedPrefix
+		}
+		return prefix + name
+	case Reserved2:
+		if rename, ok := config.ReservedNames[name]; ok && rename != "" {
+			return rename
+		}
+	}
+	return name + "_"
+}
@@ -0,0 +1,191 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"json-to-go/jsonparser"
+	"strconv"
+	"strings"
+)
+
+// DefaultPackage 生成测试文件时默认的包名
+const DefaultPackage = "main"
+
+// 往返测试的模板，%[1]s包名，%[2]s根类型，%[3]s变量类型，%[4]s json样例，%[5]s空接口
+const roundTripSource = `// Code generated by json-to-go. DO NOT EDIT.
+
+package %[1]s
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+const %[2]sSample = %[4]s
+
+// Test%[2]sRoundTrip 把样例解析到生成的类型后再序列化，比较前后的json，报告丢失或者类型改变的属性
+func Test%[2]sRoundTrip(t *testing.T) {
+	var v %[3]s
+	if err := json.Unmarshal([]byte(%[2]sSample), &v); err != nil {
+		t.Fatalf("unmarshal sample: %%v", err)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %%v", err)
+	}
+	want := decode%[2]sRoundTrip(t, []byte(%[2]sSample))
+	got := decode%[2]sRoundTrip(t, data)
+	compare%[2]sRoundTrip(t, "$", want, got)
+}
+
+func decode%[2]sRoundTrip(t *testing.T, data []byte) %[5]s {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// 数字使用json.Number，避免精度丢失影响比较
+	decoder.UseNumber()
+	var v %[5]s
+	if err := decoder.Decode(&v); err != nil {
+		t.Fatalf("decode: %%v", err)
+	}
+	return v
+}
+
+func compare%[2]sRoundTrip(t *testing.T, path string, want, got %[5]s) {
+	switch w := want.(type) {
+	case map[string]%[5]s:
+		g, ok := got.(map[string]%[5]s)
+		if !ok {
+			t.Errorf("%%s: type changed from object to %%T", path, got)
+			return
+		}
+		keys := make([]string, 0, len(w))
+		for k := range w {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			gv, ok := g[k]
+			if !ok {
+				t.Errorf("%%s.%%s: field dropped", path, k)
+				continue
+			}
+			compare%[2]sRoundTrip(t, path+"."+k, w[k], gv)
+		}
+	case []%[5]s:
+		g, ok := got.([]%[5]s)
+		if !ok {
+			t.Errorf("%%s: type changed from array to %%T", path, got)
+			return
+		}
+		if len(w) != len(g) {
+			t.Errorf("%%s: length changed from %%d to %%d", path, len(w), len(g))
+			return
+		}
+		for i := range w {
+			compare%[2]sRoundTrip(t, path+"["+strconv.Itoa(i)+"]", w[i], g[i])
+		}
+	case json.Number:
+		g, ok := got.(json.Number)
+		if !ok {
+			t.Errorf("%%s: type changed from number %%s to %%T %%v", path, w, got, got)
+			return
+		}
+		// 1.0和1，1e3和1000是相等的
+		wr, ok1 := new(big.Rat).SetString(w.String())
+		gr, ok2 := new(big.Rat).SetString(g.String())
+		if !ok1 || !ok2 || wr.Cmp(gr) != 0 {
+			t.Errorf("%%s: value changed from %%s to %%s", path, w, g)
+		}
+	default:
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("%%s: value changed from %%#v (%%T) to %%#v (%%T)", path, want, want, got, got)
+		}
+	}
+}
+`
+
+// GenerateTest 生成往返测试文件(_test.go)的内容，内嵌json样例，验证生成的类型解析和序列化后没有丢失数据
+// 样例中的注释会被去掉，和Generate一样，最外层的数组只保留对象元素，同样受MaxBytes和MaxDepth限制
+func GenerateTest(jsonStr string, config *Config) (string, error) {
+	if err := checkBytes(len(jsonStr), config); err != nil {
+		return err.Error(), err
+	}
+	// compactJSON的递归层数不会超过解析的层数
+	root, err := parseDepth([]byte(jsonStr), config)
+	if err == nil && root.Type != jsonparser.Object && root.Type != jsonparser.Array {
+		err = jsonparser.MalformedObjectError
+	}
+	if err != nil {
+		return err.Error(), err
+	}
+	var compact bytes.Buffer
+	compactJSON(&compact, root, true)
+	var sample bytes.Buffer
+	if err = json.Indent(&sample, compact.Bytes(), "", "  "); err != nil {
+		return err.Error(), err
+	}
+	literal := "`" + sample.String() + "`"
+	if strings.Contains(sample.String(), "`") {
+		literal = strconv.Quote(sample.String())
+	}
+	packageName := config.PackageName
+	if packageName == "" {
+		packageName = DefaultPackage
+	}
+	rootType := DefaultName
+	if root.Type == jsonparser.Array {
+		rootType = "[]" + DefaultName
+	}
+	buff := fmt.Sprintf(roundTripSource, packageName, DefaultName, rootType, literal, anyType(config))
+	source, err := format.Source([]byte(buff))
+	if err != nil {
+		return err.Error(), err
+	}
+	return string(source), nil
+}
+
+// 把解析好的json转换为标准的json，去掉注释和多余的逗号
+// objectsOnly为true时数组只保留对象元素，用于最外层的数组，其他元素不能解析到生成的结构体
+func compactJSON(buff *bytes.Buffer, value *jsonparser.Value, objectsOnly bool) {
+	switch value.Type {
+	case jsonparser.String:
+		buff.WriteString(`"`)
+		buff.Write(value.Data)
+		buff.WriteString(`"`)
+	case jsonparser.Object:
+		buff.WriteString("{")
+		for i, field := range value.Children {
+			if i > 0 {
+				buff.WriteString(",")
+			}
+			// key已经去转义了，重新编码
+			k, _ := json.Marshal(string(field.Key))
+			buff.Write(k)
+			buff.WriteString(":")
+			compactJSON(buff, field, false)
+		}
+		buff.WriteString("}")
+	case jsonparser.Array:
+		buff.WriteString("[")
+		first := true
+		for _, v := range value.Children {
+			if objectsOnly && v.Type != jsonparser.Object {
+				continue
+			}
+			if !first {
+				buff.WriteString(",")
+			}
+			first = false
+			compactJSON(buff, v, false)
+		}
+		buff.WriteString("]")
+	default:
+		buff.Write(value.Data)
+	}
+}
@@ -0,0 +1,144 @@
+package core
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateTest(t *testing.T) {
+	if testing.Short() {
+		t.Skip("需要运行go test")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("没有找到go")
+	}
+	tests := []struct {
+		name    string
+		jsonStr string
+		config  *Config
+		// 生成的测试需要报告的错误，为空时测试需要通过
+		want string
+	}{
+		{
+			name: "无损",
+			jsonStr: `[{
+  // 注释会被去掉
+  "id": 1,
+  "f": 10.0,
+  "s": "a|b",
+  "list": [{"a": 1}, {"b": "x"}],
+}]`,
+			config: &Config{},
+		},
+		{
+			name:    "超出范围的整数使用float64",
+			jsonStr: `{"id": 12345678901234567890123}`,
+			config:  &Config{},
+			want:    "$.id: value changed from 12345678901234567890123 to 1.2345678901234568e+22",
+		},
+		{
+			name:    "超出范围的整数使用json.Number",
+			jsonStr: `{"id": 12345678901234567890123}`,
+			config:  &Config{Overflow: Overflow1, GoVersion: "1.18"},
+		},
+		{
+			name:    "字符串形式的数字使用json.Number",
+			jsonStr: `{"id": "12345678901234567", "big": "123456789012345678901234567890"}`,
+			config:  &Config{Quoted: Quoted2},
+		},
+		{
+			name:    "字符串形式的超出范围的整数使用json.Number",
+			jsonStr: `{"id": "12", "big": "123456789012345678901234567890"}`,
+			config:  &Config{Quoted: Quoted1, Overflow: Overflow1},
+		},
+		{
+			name: "最外层的值前面有注释",
+			jsonStr: `// 注释
+{"id": 1}`,
+			config: &Config{},
+		},
+		{
+			name:    "最外层的数组包含基础类型",
+			jsonStr: `[1, "a", {"id": 1}, null]`,
+			config:  &Config{},
+		},
+		{
+			name:    "最外层的数组只有基础类型",
+			jsonStr: `[1, 2]`,
+			config:  &Config{},
+		},
+		{
+			name:    "null使用包装sql.Null*的类型",
+			jsonStr: `[{"name": "a", "age": 1, "score": 1.5, "vip": true}, {"name": null, "age": null, "score": null, "vip": null}]`,
+			config:  &Config{Nullable: Nullable2},
+		},
+		{
+			name:    "null",
+			jsonStr: `[{"name": "a"}, {"name": null}]`,
+			config:  &Config{},
+			want:    `$[1].name: value changed from <nil> (<nil>) to "" (string)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonStr := strings.ReplaceAll(tt.jsonStr, "|", "`")
+			types, err := Generate(jsonStr, tt.config)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			test, err := GenerateTest(jsonStr, tt.config)
+			if err != nil {
+				t.Fatalf("GenerateTest() error = %v", err)
+			}
+			dir := t.TempDir()
+			files := map[string]string{
+				"go.mod":        "module roundtrip\n\ngo 1.19\n",
+				"types.go":      "package main\n\nimport (\n\"database/sql\"\n\"encoding/json\"\n)\n\nvar _ json.Number\nvar _ sql.NullString\n\nfunc main() {}\n\n" + types,
+				"types_test.go": test,
+			}
+			for name, content := range files {
+				if err = os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			cmd := exec.Command(goBin, "test", ".")
+			cmd.Dir = dir
+			out, err := cmd.CombinedOutput()
+			if tt.want == "" && err != nil {
+				t.Errorf("go test failed: %s", out)
+			}
+			if tt.want != "" && (err == nil || !strings.Contains(string(out), tt.want)) {
+				t.Errorf("go test output = %s, want %s", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateTestLimit(t *testing.T) {
+	deep := `{"a":` + strings.Repeat("[", 100000) + strings.Repeat("]", 100000) + "}"
+	json := `{"a":[1]}`
+	tests := []struct {
+		name   string
+		json   string
+		config Config
+		limit  string
+	}{
+		{name: "deep default", json: deep, config: Config{}, limit: LimitDepth},
+		{name: "depth", json: json, config: Config{MaxDepth: 1}, limit: LimitDepth},
+		{name: "bytes", json: json, config: Config{MaxBytes: len(json) - 1}, limit: LimitBytes},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := GenerateTest(tt.json, &tt.config)
+			var limitErr *LimitError
+			if !errors.As(err, &limitErr) || limitErr.Limit != tt.limit {
+				t.Errorf("GenerateTest() error = %v, want %v", err, tt.limit)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SingularRule 复数转单数的规则，单词以Suffix结尾时替换为Replacement，使用小写
+type SingularRule struct {
+	Suffix      string
+	Replacement string
+}
+
+// 内置的复数转单数的规则，按顺序匹配，自定义的规则使用Config.SingularRules
+var singularRules = []SingularRule{
+	{Suffix: "sses", Replacement: "ss"},
+	{Suffix: "shes", Replacement: "sh"},
+	{Suffix: "ches", Replacement: "ch"},
+	{Suffix: "xes", Replacement: "x"},
+	{Suffix: "zzes", Replacement: "zz"},
+	{Suffix: "ies", Replacement: "y"},
+	{Suffix: "oes", Replacement: "o"},
+	// 本身就是单数
+	{Suffix: "ss", Replacement: "ss"},
+	{Suffix: "us", Replacement: "us"},
+	{Suffix: "is", Replacement: "is"},
+	{Suffix: "s", Replacement: ""},
+}
+
+// 内置的不规则的复数和单数，key使用小写，value为空表示单复数相同，自定义的使用Config.Irregulars
+var irregulars = map[string]string{
+	"people":      "person",
+	"men":         "man",
+	"women":       "woman",
+	"children":    "child",
+	"mice":        "mouse",
+	"geese":       "goose",
+	"feet":        "foot",
+	"teeth":       "tooth",
+	"oxen":        "ox",
+	"criteria":    "criterion",
+	"phenomena":   "phenomenon",
+	"indices":     "index",
+	"matrices":    "matrix",
+	"vertices":    "vertex",
+	"analyses":    "analysis",
+	"bases":       "basis",
+	"crises":      "crisis",
+	"theses":      "thesis",
+	"leaves":      "leaf",
+	"lives":       "life",
+	"knives":      "knife",
+	"wives":       "wife",
+	"halves":      "half",
+	"shelves":     "shelf",
+	"wolves":      "wolf",
+	"aliases":     "alias",
+	"statuses":    "status",
+	"buses":       "bus",
+	"quizzes":     "quiz",
+	"movies":      "movie",
+	"cookies":     "cookie",
+	"shoes":       "shoe",
+	"data":        "",
+	"metadata":    "",
+	"media":       "",
+	"news":        "",
+	"series":      "",
+	"species":     "",
+	"info":        "",
+	"information": "",
+	"equipment":   "",
+	"sheep":       "",
+	"fish":        "",
+	"deer":        "",
+}
+
+// 将json属性名的最后一个单词转换为单数，例如order_items转换为order_item
+func singularKey(key string, config *Config) string {
+	newKey := convertToUnderline(key, config)
+	runes := []rune(newKey)
+	start := len(runes)
+	for start > 0 && runes[start-1] < unicode.MaxASCII && unicode.IsLetter(runes[start-1]) {
+		start--
+	}
+	word := string(runes[start:])
+	if word == "" {
+		return key
+	}
+	return string(runes[:start]) + restoreCase(word, singularize(strings.ToLower(word), config))
+}
+
+// 小写的英文单词转换为单数，自定义的规则优先于内置的规则
+func singularize(word string, config *Config) string {
+	if singular, ok := config.Irregulars[word]; ok {
+		return irregularSingular(word, singular)
+	}
+	if singular, ok := irregulars[word]; ok {
+		return irregularSingular(word, singular)
+	}
+	for _, rules := range [][]SingularRule{config.SingularRules, singularRules} {
+		for _, rule := range rules {
+			// 转换后至少保留一个字母
+			if strings.HasSuffix(word, rule.Suffix) && len(word) > len(rule.Suffix) {
+				return word[:len(word)-len(rule.Suffix)] + rule.Replacement
+			}
+		}
+	}
+	return word
+}
+
+// 不规则的复数对应的单数，单数为空表示单复数相同
+func irregularSingular(word, singular string) string {
+	if singular == "" {
+		return word
+	}
+	return singular
+}
+
+// 按照原单词的大小写格式处理转换后的单词
+func restoreCase(word, result string) string {
+	if word == strings.ToLower(word) {
+		return result
+	}
+	if word == strings.ToUpper(word) {
+		return strings.ToUpper(result)
+	}
+	if unicode.IsUpper(rune(word[0])) {
+		return strings.ToUpper(result[:1]) + result[1:]
+	}
+	return result
+}
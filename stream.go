@@ -0,0 +1,312 @@
+package core
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"json-to-go/jsonparser"
+	"math/rand"
+)
+
+// 流式读取时的采样方式
+const (
+	// 读取全部记录
+	Sample0 = iota
+	// 只读取前SampleSize条记录，之后的内容不再读取
+	Sample1
+	// 蓄水池采样，随机保留SampleSize条记录
+	Sample2
+	// 每SampleSize条记录取一条
+	Sample3
+)
+
+// EmptyStreamError 输入中没有可以推断类型的对象
+var EmptyStreamError = errors.New("Stream does not contain any object")
+
+// 前N条采样读取结束后，用来停止读取
+var stopStreamError = errors.New("stop stream")
+
+// GenerateReader 从io.Reader流式读取json，顶层数组的元素和换行分隔的json(NDJSON)逐条合并，不需要一次读入全部内容
+// 生成示例时只使用第一条记录
+func GenerateReader(r io.Reader, config *Config) (string, error) {
+	r = newLimitReader(r, config)
+	if config.StructType == "map" {
+		// map需要完整的值
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err.Error(), err
+		}
+		return Generate(string(data), config)
+	}
+	config = setJsonTag(config)
+	parent := NewNode(DefaultName, "", GroupO, "")
+	state := newInferState(config)
+	var example *jsonparser.Value
+	err := eachSample(r, config, func(record []byte) error {
+		if example == nil {
+			// record会被覆盖，示例使用复制的数据
+			record = append([]byte(nil), record...)
+		}
+		root, err := parseDepth(record, config)
+		if err != nil {
+			return err
+		}
+		if err = recursionNode(parent, root, state); err != nil {
+			return err
+		}
+		// 每条记录合并后压缩，节点数量只和类型的种类有关
+		compactNode(parent)
+		if example == nil {
+			example = root
+		}
+		return nil
+	})
+	if err == nil && example == nil {
+		err = EmptyStreamError
+	}
+	if err != nil {
+		return err.Error(), err
+	}
+	return generateSource(parent, example, config)
+}
+
+// 按照采样方式处理每条记录
+func eachSample(r io.Reader, config *Config, fn func(record []byte) error) error {
+	size := config.SampleSize
+	if size <= 0 {
+		return eachRecord(r, fn)
+	}
+	count := 0
+	switch config.Sample {
+	case Sample1:
+		err := eachRecord(r, func(record []byte) error {
+			if count >= size {
+				return stopStreamError
+			}
+			count++
+			return fn(record)
+		})
+		if err == stopStreamError {
+			return nil
+		}
+		return err
+	case Sample2:
+		random := rand.New(rand.NewSource(config.SampleSeed))
+		samples := make([][]byte, 0, size)
+		err := eachRecord(r, func(record []byte) error {
+			count++
+			if len(samples) < size {
+				samples = append(samples, append([]byte(nil), record...))
+			} else if i := random.Int63n(int64(count)); i < int64(size) {
+				samples[i] = append(samples[i][:0], record...)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, record := range samples {
+			if err = fn(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	case Sample3:
+		return eachRecord(r, func(record []byte) error {
+			count++
+			if (count-1)%size != 0 {
+				return nil
+			}
+			return fn(record)
+		})
+	}
+	return eachRecord(r, fn)
+}
+
+// 依次读取顶层的值，数组逐个读取元素，只处理对象，和Generate一致
+// record在下次调用时会被覆盖
+func eachRecord(r io.Reader, fn func(record []byte) error) error {
+	reader := &recordReader{reader: bufio.NewReader(r)}
+	for {
+		c, err := reader.peek()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if c != '[' {
+			if err = reader.readObject(fn); err != nil {
+				return err
+			}
+			continue
+		}
+		// 顶层数组
+		_, _ = reader.reader.ReadByte()
+		for {
+			if c, err = reader.peek(); err != nil {
+				return unexpectedEOF(err)
+			}
+			if c == ']' {
+				_, _ = reader.reader.ReadByte()
+				break
+			}
+			if err = reader.readObject(fn); err != nil {
+				return err
+			}
+			if c, err = reader.peek(); err != nil {
+				return unexpectedEOF(err)
+			}
+			_, _ = reader.reader.ReadByte()
+			if c == ']' {
+				break
+			}
+			if c != ',' {
+				return jsonparser.MalformedArrayError
+			}
+		}
+	}
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return jsonparser.MalformedJsonError
+	}
+	return err
+}
+
+// 按记录读取json，缓冲区复用，内存只和最大的记录有关
+type recordReader struct {
+	reader *bufio.Reader
+	buff   []byte
+}
+
+// 跳过空白和记录之间的注释，返回下一个字符，不会读取这个字符
+func (r *recordReader) peek() (byte, error) {
+	for {
+		c, err := r.reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch c {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case '/':
+			if err = r.comment(false); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		return c, r.reader.UnreadByte()
+	}
+}
+
+// 读取'/'之后的注释，save为true时把注释写入缓冲区
+func (r *recordReader) comment(save bool) error {
+	c, err := r.reader.ReadByte()
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	if c != '/' && c != '*' {
+		return jsonparser.MalformedJsonError
+	}
+	if save {
+		r.buff = append(r.buff, c)
+	}
+	last := byte(0)
+	for {
+		b, err := r.reader.ReadByte()
+		if err != nil {
+			if c == '/' && err == io.EOF {
+				return nil
+			}
+			return unexpectedEOF(err)
+		}
+		if c == '/' && b == '\n' {
+			return r.reader.UnreadByte()
+		}
+		if save {
+			r.buff = append(r.buff, b)
+		}
+		if c == '*' && last == '*' && b == '/' {
+			return nil
+		}
+		last = b
+	}
+}
+
+// 读取一个值，对象交给fn处理，其他类型的值直接跳过
+func (r *recordReader) readObject(fn func(record []byte) error) error {
+	r.buff = r.buff[:0]
+	c, err := r.reader.ReadByte()
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	if c != '{' && c != '[' {
+		// 基础类型读取到分隔符为止
+		for {
+			if c == '"' {
+				if err = r.readString(false); err != nil {
+					return err
+				}
+			}
+			if c, err = r.reader.ReadByte(); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			switch c {
+			case ' ', '\t', '\n', '\r', ',', ']', '/':
+				return r.reader.UnreadByte()
+			}
+		}
+	}
+	depth := 0
+	for {
+		r.buff = append(r.buff, c)
+		switch c {
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+		case '"':
+			err = r.readString(true)
+		case '/':
+			err = r.comment(true)
+		}
+		if err != nil {
+			return err
+		}
+		if depth == 0 {
+			break
+		}
+		if c, err = r.reader.ReadByte(); err != nil {
+			return unexpectedEOF(err)
+		}
+	}
+	if r.buff[0] != '{' {
+		return nil
+	}
+	return fn(r.buff)
+}
+
+// 读取字符串剩余的部分，包括结尾的引号
+func (r *recordReader) readString(save bool) error {
+	escaped := false
+	for {
+		c, err := r.reader.ReadByte()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		if save {
+			r.buff = append(r.buff, c)
+		}
+		if escaped {
+			escaped = false
+		} else if c == '\\' {
+			escaped = true
+		} else if c == '"' {
+			return nil
+		}
+	}
+}
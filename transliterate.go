@@ -0,0 +1,218 @@
+package core
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 汉字转拼音的方式
+const (
+	// 只使用拼音首字母
+	Pinyin0 = iota
+	// 使用完整的拼音，每个汉字作为一个单词，例如用户名转换为YongHuMing
+	Pinyin1
+)
+
+// FallbackName 属性名转换后为空时使用的名称
+const FallbackName = "Field"
+
+// 平假名转罗马字(平文式)，片假名先转换为平假名
+var kanaRomaji = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "i", 'ゑ': "e", 'を': "o", 'ん': "n", 'ゔ': "vu",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o", 'ゎ': "wa",
+}
+
+// 拗音，小写的ゃゅょ和前一个假名组合
+var kanaYoon = map[rune]string{'ゃ': "a", 'ゅ': "u", 'ょ': "o"}
+
+// 谚文的初声、中声、终声(韩国语罗马字标记法)
+var (
+	hangulInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	hangulMedials  = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	hangulFinals   = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+)
+
+// 西里尔字母转拉丁字母，使用小写
+var cyrillicLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g", 'ў': "u",
+}
+
+// 带变音符号的拉丁字母转ASCII，小写和大写分别处理
+var latinFolding = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE", 'ø': "o", 'Ø': "O",
+	'ð': "d", 'Ð': "D", 'þ': "th", 'Þ': "TH", 'đ': "d", 'Đ': "D", 'ħ': "h", 'Ħ': "H",
+	'ı': "i", 'ł': "l", 'Ł': "L", 'ŀ': "l", 'Ŀ': "L", 'ŉ': "n", 'ŋ': "ng", 'Ŋ': "NG",
+	'ĸ': "k", 'ſ': "s", 'ŧ': "t", 'Ŧ': "T", 'ĳ': "ij", 'Ĳ': "IJ",
+}
+
+// 按照基本字母分组的变音字母
+var latinDiacritics = []struct {
+	base    string
+	letters string
+}{
+	{"a", "àáâãäåāăą"}, {"A", "ÀÁÂÃÄÅĀĂĄ"},
+	{"c", "çćĉċč"}, {"C", "ÇĆĈĊČ"},
+	{"d", "ď"}, {"D", "Ď"},
+	{"e", "èéêëēĕėęě"}, {"E", "ÈÉÊËĒĔĖĘĚ"},
+	{"g", "ĝğġģ"}, {"G", "ĜĞĠĢ"},
+	{"h", "ĥ"}, {"H", "Ĥ"},
+	{"i", "ìíîïĩīĭįǐ"}, {"I", "ÌÍÎÏĨĪĬĮİǏ"},
+	{"j", "ĵ"}, {"J", "Ĵ"},
+	{"k", "ķ"}, {"K", "Ķ"},
+	{"l", "ĺļľ"}, {"L", "ĹĻĽ"},
+	{"n", "ñńņň"}, {"N", "ÑŃŅŇ"},
+	{"o", "òóôõöōŏőǒ"}, {"O", "ÒÓÔÕÖŌŎŐǑ"},
+	{"r", "ŕŗř"}, {"R", "ŔŖŘ"},
+	{"s", "śŝşš"}, {"S", "ŚŜŞŠ"},
+	{"t", "ţť"}, {"T", "ŢŤ"},
+	{"u", "ùúûüũūŭůűųǔǖǘǚǜ"}, {"U", "ÙÚÛÜŨŪŬŮŰŲǓǕǗǙǛ"},
+	{"w", "ŵ"}, {"W", "Ŵ"},
+	{"y", "ýÿŷ"}, {"Y", "ÝŸŶ"},
+	{"z", "źżž"}, {"Z", "ŹŻŽ"},
+}
+
+func init() {
+	for _, d := range latinDiacritics {
+		for _, r := range d.letters {
+			latinFolding[r] = d.base
+		}
+	}
+}
+
+// 将非ASCII的字符转换为ASCII，转换后的单词前后使用下划线分割
+// 只使用拼音首字母并且不转换其他文字时保持原来的处理方式
+func transliterate(key string, config *Config) string {
+	if config.Pinyin == Pinyin0 && !config.TransliterateFlag {
+		return key
+	}
+	var buffer strings.Builder
+	runes := []rune(key)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r < unicode.MaxASCII:
+			buffer.WriteRune(r)
+		case unicode.Is(unicode.Han, r):
+			if config.Pinyin == Pinyin1 {
+				buffer.WriteString("_" + GetFullPinYin(string(r)) + "_")
+			} else {
+				buffer.WriteRune(r)
+			}
+		case !config.TransliterateFlag:
+			buffer.WriteRune(r)
+		case isKana(r):
+			// 连续的假名作为一个单词
+			j := i
+			for j < len(runes) && isKana(runes[j]) {
+				j++
+			}
+			buffer.WriteString("_" + kanaToRomaji(runes[i:j]) + "_")
+			i = j - 1
+		case r >= 0xAC00 && r <= 0xD7A3:
+			// 连续的谚文作为一个单词
+			j := i
+			for j < len(runes) && runes[j] >= 0xAC00 && runes[j] <= 0xD7A3 {
+				j++
+			}
+			buffer.WriteString("_" + hangulToLatin(runes[i:j]) + "_")
+			i = j - 1
+		case unicode.Is(unicode.Cyrillic, r):
+			s, ok := cyrillicLatin[unicode.ToLower(r)]
+			if !ok {
+				continue
+			}
+			// 大写字母转换后首字母大写，用于驼峰分割
+			if unicode.IsUpper(r) && s != "" {
+				s = strings.ToUpper(s[:1]) + s[1:]
+			}
+			buffer.WriteString(s)
+		default:
+			if s, ok := latinFolding[r]; ok {
+				buffer.WriteString(s)
+			} else {
+				buffer.WriteRune(r)
+			}
+		}
+	}
+	return buffer.String()
+}
+
+func isKana(r rune) bool {
+	return r >= 'ぁ' && r <= 'ゔ' || r >= 'ァ' && r <= 'ヴ' || r == 'ー'
+}
+
+// 假名转平文式罗马字，长音符号省略
+func kanaToRomaji(runes []rune) string {
+	var result strings.Builder
+	// 促音，下一个音节的辅音重复
+	sokuon := false
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r >= 'ァ' && r <= 'ヴ' {
+			r -= 'ァ' - 'ぁ'
+		}
+		if r == 'っ' {
+			sokuon = true
+			continue
+		}
+		s, ok := kanaRomaji[r]
+		if !ok {
+			continue
+		}
+		// 拗音，例如きゃ转换为kya，しゃ转换为sha
+		if i+1 < len(runes) {
+			next := runes[i+1]
+			if next >= 'ァ' && next <= 'ヴ' {
+				next -= 'ァ' - 'ぁ'
+			}
+			if v, ok := kanaYoon[next]; ok && len(s) > 1 && strings.HasSuffix(s, "i") {
+				s = strings.TrimSuffix(s, "i")
+				if s != "sh" && s != "ch" && s != "j" {
+					s += "y"
+				}
+				s += v
+				i++
+			}
+		}
+		if sokuon && s[0] != 'a' && s[0] != 'i' && s[0] != 'u' && s[0] != 'e' && s[0] != 'o' {
+			if strings.HasPrefix(s, "ch") {
+				result.WriteByte('t')
+			} else {
+				result.WriteByte(s[0])
+			}
+		}
+		sokuon = false
+		result.WriteString(s)
+	}
+	return result.String()
+}
+
+// 谚文按照音节逐字转换为韩国语罗马字
+func hangulToLatin(runes []rune) string {
+	var result strings.Builder
+	for _, r := range runes {
+		index := int(r - 0xAC00)
+		result.WriteString(hangulInitials[index/(21*28)])
+		result.WriteString(hangulMedials[index%(21*28)/28])
+		result.WriteString(hangulFinals[index%28])
+	}
+	return result.String()
+}
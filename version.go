@@ -0,0 +1,42 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+)
+
+// 从这个版本开始支持泛型和any
+const goVersionGenerics = 18
+
+// 解析Config.GoVersion的次版本号，支持"1.18"、"go1.21.3"这样的格式
+// 没有设置或者无法解析时返回-1
+func goMinorVersion(config *Config) int {
+	v := strings.TrimPrefix(strings.TrimSpace(config.GoVersion), "go")
+	split := strings.Split(v, ".")
+	if len(split) < 2 || split[0] != "1" {
+		return -1
+	}
+	minor, err := strconv.Atoi(split[1])
+	if err != nil {
+		return -1
+	}
+	return minor
+}
+
+// 目标go版本是否大于等于1.minor，没有设置版本时按照不支持处理
+func goVersionAtLeast(config *Config, minor int) bool {
+	return goMinorVersion(config) >= minor
+}
+
+// 空接口的写法，1.18以上使用any
+func anyType(config *Config) string {
+	if goVersionAtLeast(config, goVersionGenerics) {
+		return "any"
+	}
+	return TypeAny
+}
+
+// 是否可以使用泛型，和anyType一样，没有设置版本时按照不支持泛型处理
+func genericsEnabled(config *Config) bool {
+	return goVersionAtLeast(config, goVersionGenerics)
+}